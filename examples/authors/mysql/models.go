@@ -7,6 +7,7 @@ import (
 )
 
 type Author struct {
+	// Identity column
 	ID   int64
 	Name string
 	Bio  sql.NullString
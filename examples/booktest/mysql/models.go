@@ -27,17 +27,23 @@ func (e *BooksBookType) Scan(src interface{}) error {
 }
 
 type Author struct {
+	// Identity column
 	AuthorID int32
 	Name     string
 }
 
 type Book struct {
-	BookID    int32
-	AuthorID  int32
-	Isbn      string
-	BookType  BooksBookType
-	Title     string
-	Yr        int32
+	// Identity column
+	BookID   int32
+	AuthorID int32
+	// Default: ''
+	Isbn string
+	// Default: 'FICTION'
+	BookType BooksBookType
+	Title    string
+	// Default: 2000
+	Yr int32
+	// Default: CURRENT_TIMESTAMP()
 	Available time.Time
 	Tags      string
 }
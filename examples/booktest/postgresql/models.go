@@ -28,16 +28,23 @@ func (e *BookType) Scan(src interface{}) error {
 
 type Author struct {
 	AuthorID int32
-	Name     string
+	// Default: ''
+	Name string
 }
 
 type Book struct {
-	BookID    int32
-	AuthorID  int32
-	Isbn      string
-	BookType  BookType
-	Title     string
-	Year      int32
+	BookID   int32
+	AuthorID int32
+	// Default: ''
+	Isbn string
+	// Default: 'FICTION'
+	BookType BookType
+	// Default: ''
+	Title string
+	// Default: 2000
+	Year int32
+	// Default: 'NOW()'
 	Available time.Time
-	Tags      []string
+	// Default: '{}'
+	Tags []string
 }
@@ -3,6 +3,7 @@
 package com.example.authors.mysql
 
 data class Author (
+  // Identity column
   val id: Long,
   val name: String,
   val bio: String?
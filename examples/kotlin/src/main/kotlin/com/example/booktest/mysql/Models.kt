@@ -15,17 +15,23 @@ enum class BooksBookType(val value: String) {
 }
 
 data class Author (
+  // Identity column
   val authorId: Int,
   val name: String
 )
 
 data class Book (
+  // Identity column
   val bookId: Int,
   val authorId: Int,
+  // Default: ''
   val isbn: String,
+  // Default: 'FICTION'
   val bookType: BooksBookType,
   val title: String,
+  // Default: 2000
   val yr: Int,
+  // Default: CURRENT_TIMESTAMP()
   val available: LocalDateTime,
   val tags: String
 )
@@ -16,17 +16,24 @@ enum class BookType(val value: String) {
 
 data class Author (
   val authorId: Int,
+  // Default: ''
   val name: String
 )
 
 data class Book (
   val bookId: Int,
   val authorId: Int,
+  // Default: ''
   val isbn: String,
+  // Default: 'FICTION'
   val bookType: BookType,
+  // Default: ''
   val title: String,
+  // Default: 2000
   val year: Int,
+  // Default: 'NOW()'
   val available: OffsetDateTime,
+  // Default: '{}'
   val tags: List<String>
 )
 
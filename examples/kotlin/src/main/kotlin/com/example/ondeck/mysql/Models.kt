@@ -22,6 +22,7 @@ data class City (
 
 // Venues are places where muisc happens
 data class Venue (
+  // Identity column
   val id: Long,
   // Venues can be either open or closed
   val status: VenuesStatus,
@@ -33,6 +34,7 @@ data class Venue (
   val spotifyPlaylist: String,
   val songkickId: String?,
   val tags: String?,
+  // Default: CURRENT_TIMESTAMP()
   val createdAt: Instant
 )
 
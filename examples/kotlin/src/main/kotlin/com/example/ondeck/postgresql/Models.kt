@@ -32,6 +32,7 @@ data class Venue (
   val spotifyPlaylist: String,
   val songkickId: String?,
   val tags: List<String>,
+  // Default: now()
   val createdAt: LocalDateTime
 )
 
@@ -34,6 +34,7 @@ type City struct {
 
 // Venues are places where muisc happens
 type Venue struct {
+	// Identity column
 	ID int64 `json:"id"`
 	// Venues can be either open or closed
 	Status   VenuesStatus   `json:"status"`
@@ -45,5 +46,6 @@ type Venue struct {
 	SpotifyPlaylist string         `json:"spotify_playlist"`
 	SongkickID      sql.NullString `json:"songkick_id"`
 	Tags            sql.NullString `json:"tags"`
-	CreatedAt       time.Time      `json:"created_at"`
+	// Default: CURRENT_TIMESTAMP()
+	CreatedAt time.Time `json:"created_at"`
 }
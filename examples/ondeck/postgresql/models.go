@@ -45,5 +45,6 @@ type Venue struct {
 	SpotifyPlaylist string         `json:"spotify_playlist"`
 	SongkickID      sql.NullString `json:"songkick_id"`
 	Tags            []string       `json:"tags"`
-	CreatedAt       time.Time      `json:"created_at"`
+	// Default: now()
+	CreatedAt time.Time `json:"created_at"`
 }
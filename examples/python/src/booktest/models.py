@@ -15,6 +15,7 @@ class BookType(str, enum.Enum):
 @dataclasses.dataclass()
 class Author:
     author_id: int
+    # Default: ''
     name: str
 
 
@@ -23,11 +24,17 @@ class Author:
 class Book:
     book_id: int
     author_id: int
+    # Default: ''
     isbn: str
+    # Default: 'FICTION'
     book_type: BookType
+    # Default: ''
     title: str
+    # Default: 2000
     year: int
+    # Default: 'NOW()'
     available: datetime.datetime
+    # Default: '{}'
     tags: List[str]
 
 
@@ -31,6 +31,7 @@ class Venue:
     spotify_playlist: str
     songkick_id: Optional[str]
     tags: Optional[List[str]]
+    # Default: now()
     created_at: datetime.datetime
 
 
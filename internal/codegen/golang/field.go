@@ -5,7 +5,9 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/kyleconroy/sqlc/internal/compiler"
 	"github.com/kyleconroy/sqlc/internal/config"
+	"github.com/kyleconroy/sqlc/internal/sql/named"
 )
 
 type Field struct {
@@ -13,6 +15,17 @@ type Field struct {
 	Type    string
 	Tags    map[string]string
 	Comment string
+	Column  *compiler.Column
+	// EmbedFields are the fields of the model struct for sqlc.embed()
+	EmbedFields []Field
+}
+
+func (gf Field) IsSqlcSlice() bool {
+	return gf.Column != nil && gf.Column.IsSqlcSlice
+}
+
+func (gf Field) SlicePlaceholder() string {
+	return named.SlicePlaceholder(gf.Column.Name)
 }
 
 func (gf Field) Tag() string {
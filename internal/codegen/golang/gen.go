@@ -11,6 +11,7 @@ import (
 	"github.com/kyleconroy/sqlc/internal/codegen"
 	"github.com/kyleconroy/sqlc/internal/compiler"
 	"github.com/kyleconroy/sqlc/internal/config"
+	"github.com/kyleconroy/sqlc/internal/metadata"
 )
 
 type Generateable interface {
@@ -35,6 +36,42 @@ import (
 {{end}}
 
 {{define "dbCode"}}
+{{if .UsePgx}}
+{{- template "dbCodePgx" .}}
+{{else}}
+{{- template "dbCodeStd" .}}
+{{end}}
+{{end}}
+
+{{define "dbCodePgx"}}
+type DBTX interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...interface{}) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...interface{}) pgx.Row
+	{{- if .UsesBatch}}
+	SendBatch(context.Context, *pgx.Batch) pgx.BatchResults
+	{{- end}}
+	{{- if .UsesCopyFrom}}
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+	{{- end}}
+}
+
+func New(db DBTX) *Queries {
+	return &Queries{db: db}
+}
+
+type Queries struct {
+	db DBTX
+}
+
+func (q *Queries) WithTx(tx pgx.Tx) *Queries {
+	return &Queries{
+		db: tx,
+	}
+}
+{{end}}
+
+{{define "dbCodeStd"}}
 type DBTX interface {
 	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
 	PrepareContext(context.Context, string) (*sql.Stmt, error)
@@ -160,9 +197,17 @@ type Querier interface {
 	{{- if eq .Cmd ":execrows"}}
 	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, error)
 	{{- end}}
-	{{- if eq .Cmd ":execresult"}}
+	{{- if and (eq .Cmd ":execresult") $.UsePgx}}
+	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (pgconn.CommandTag, error)
+	{{- else if eq .Cmd ":execresult"}}
 	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (sql.Result, error)
 	{{- end}}
+	{{- if .IsBatch}}
+	{{.MethodName}}(ctx context.Context, {{.Arg.SlicePair}}) *{{.MethodName}}BatchResults
+	{{- end}}
+	{{- if eq .Cmd ":copyfrom"}}
+	{{.MethodName}}(ctx context.Context, {{.Arg.SlicePair}}) (int64, error)
+	{{- end}}
 	{{- end}}
 }
 
@@ -206,6 +251,219 @@ func (e *{{.Name}}) Scan(src interface{}) error {
 	return nil
 }
 {{end}}
+{{range .CompositeTypes}}
+{{if .Comment}}{{comment .Comment}}{{end}}
+type {{.Name}} struct { {{- range .Fields}}
+  {{.Name}} {{.Type}} {{if or ($.EmitJSONTags) ($.EmitDBTags)}}{{$.Q}}{{.Tag}}{{$.Q}}{{end}}
+  {{- end}}
+}
+
+func (t *{{.Name}}) Scan(src interface{}) error {
+	fields, err := parseCompositeRow(src)
+	if err != nil {
+		return fmt.Errorf("scan {{.Name}}: %w", err)
+	}
+	if len(fields) != {{len .Fields}} {
+		return fmt.Errorf("scan {{.Name}}: expected {{len .Fields}} attributes, got %d", len(fields))
+	}
+	{{- $name := .Name}}
+	{{- range $i, $f := .Fields}}
+	if err := scanCompositeField(&t.{{$f.Name}}, fields[{{$i}}]); err != nil {
+		return fmt.Errorf("scan {{$name}}.{{$f.Name}}: %w", err)
+	}
+	{{- end}}
+	return nil
+}
+
+func (t {{.Name}}) Value() (driver.Value, error) {
+	return formatCompositeRow({{range $i, $f := .Fields}}{{if $i}}, {{end}}t.{{$f.Name}}{{end}})
+}
+
+type Null{{.Name}} struct {
+	{{.Name}} {{.Name}}
+	Valid bool // Valid is true if {{.Name}} is not NULL
+}
+
+func (n *Null{{.Name}}) Scan(src interface{}) error {
+	if src == nil {
+		n.{{.Name}}, n.Valid = {{.Name}}{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.{{.Name}}.Scan(src)
+}
+
+func (n Null{{.Name}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.{{.Name}}.Value()
+}
+{{end}}
+
+{{if .CompositeTypes}}
+// parseCompositeRow splits a PostgreSQL row literal, such as (1,"a b",), into
+// its fields. NULL fields are returned as nil.
+func parseCompositeRow(src interface{}) ([]*string, error) {
+	var s string
+	switch v := src.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return nil, fmt.Errorf("unsupported scan type: %T", src)
+	}
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, fmt.Errorf("malformed row literal: %q", s)
+	}
+	s = s[1 : len(s)-1]
+
+	var fields []*string
+	var b strings.Builder
+	quoted, null := false, true
+	field := func() {
+		if null {
+			fields = append(fields, nil)
+		} else {
+			v := b.String()
+			fields = append(fields, &v)
+		}
+		b.Reset()
+		null = true
+	}
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case ch == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case ch == '"':
+			quoted = !quoted
+		case ch == ',' && !quoted:
+			field()
+			continue
+		default:
+			b.WriteByte(ch)
+		}
+		null = false
+	}
+	field()
+	return fields, nil
+}
+
+// scanCompositeField converts the text of a row literal field into dest
+func scanCompositeField(dest interface{}, src *string) error {
+	switch d := dest.(type) {
+	case *sql.NullTime:
+		if src == nil {
+			*d = sql.NullTime{}
+			return nil
+		}
+		t, err := parseCompositeTime(*src)
+		*d = sql.NullTime{Time: t, Valid: err == nil}
+		return err
+	case sql.Scanner:
+		if src == nil {
+			return d.Scan(nil)
+		}
+		return d.Scan(*src)
+	}
+	if src == nil {
+		return fmt.Errorf("cannot scan NULL into %T", dest)
+	}
+	var err error
+	switch d := dest.(type) {
+	case *string:
+		*d = *src
+	case *[]byte:
+		*d, err = hex.DecodeString(strings.TrimPrefix(*src, "\\x"))
+	case *bool:
+		*d = *src == "t"
+	case *int16:
+		var v int64
+		v, err = strconv.ParseInt(*src, 10, 16)
+		*d = int16(v)
+	case *int32:
+		var v int64
+		v, err = strconv.ParseInt(*src, 10, 32)
+		*d = int32(v)
+	case *int64:
+		*d, err = strconv.ParseInt(*src, 10, 64)
+	case *float32:
+		var v float64
+		v, err = strconv.ParseFloat(*src, 32)
+		*d = float32(v)
+	case *float64:
+		*d, err = strconv.ParseFloat(*src, 64)
+	case *time.Time:
+		*d, err = parseCompositeTime(*src)
+	default:
+		return fmt.Errorf("unsupported field type %T", dest)
+	}
+	return err
+}
+
+func parseCompositeTime(s string) (time.Time, error) {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05.999999999Z07:00:00",
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+		"15:04:05.999999999",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse %q as a time", s)
+}
+
+// formatCompositeRow encodes fields as a PostgreSQL row literal
+func formatCompositeRow(fields ...interface{}) (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('(')
+	for i, field := range fields {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if v, ok := field.(driver.Valuer); ok {
+			var err error
+			if field, err = v.Value(); err != nil {
+				return nil, err
+			}
+		}
+		var s string
+		switch v := field.(type) {
+		case nil:
+			continue
+		case string:
+			s = v
+		case []byte:
+			s = "\\x" + hex.EncodeToString(v)
+		case bool:
+			s = strconv.FormatBool(v)
+		case time.Time:
+			s = v.Format("2006-01-02 15:04:05.999999999Z07:00")
+		default:
+			s = fmt.Sprint(v)
+		}
+		b.WriteByte('"')
+		for j := 0; j < len(s); j++ {
+			if s[j] == '"' || s[j] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(s[j])
+		}
+		b.WriteByte('"')
+	}
+	b.WriteByte(')')
+	return b.String(), nil
+}
+{{end}}
 
 {{range .Structs}}
 {{if .Comment}}{{comment .Comment}}{{end}}
@@ -236,7 +494,7 @@ import (
 
 {{define "queryCode"}}
 {{range .GoQueries}}
-{{if $.OutputQuery .SourceName}}
+{{if and ($.OutputQuery .SourceName) (not .IsBatch)}}
 const {{.ConstantName}} = {{$.Q}}-- name: {{.MethodName}} {{.Cmd}}
 {{escape .SQL}}
 {{$.Q}}
@@ -259,10 +517,15 @@ type {{.Ret.Type}} struct { {{- range .Ret.Struct.Fields}}
 {{range .Comments}}//{{.}}
 {{end -}}
 func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ({{.Ret.Type}}, error) {
-  	{{- if $.EmitPreparedQueries}}
-	row := q.queryRow(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	{{- if .Arg.HasSqlcSlices}}
+	{{- template "sqlcSliceQuery" .}}
+	{{- end}}
+  	{{- if $.UsePgx}}
+	row := q.db.QueryRow(ctx, {{.ConstantName}}, {{.Arg.Params}})
+  	{{- else if $.EmitPreparedQueries}}
+	row := q.queryRow(ctx, {{template "queryStmt" .}}, {{template "queryString" .}}, {{.Arg.Params}})
 	{{- else}}
-	row := q.db.QueryRowContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	row := q.db.QueryRowContext(ctx, {{template "queryString" .}}, {{.Arg.Params}})
 	{{- end}}
 	var {{.Ret.Name}} {{.Ret.Type}}
 	err := row.Scan({{.Ret.Scan}})
@@ -274,10 +537,15 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ({{.Ret.Ty
 {{range .Comments}}//{{.}}
 {{end -}}
 func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ([]{{.Ret.Type}}, error) {
-  	{{- if $.EmitPreparedQueries}}
-	rows, err := q.query(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	{{- if .Arg.HasSqlcSlices}}
+	{{- template "sqlcSliceQuery" .}}
+	{{- end}}
+  	{{- if $.UsePgx}}
+	rows, err := q.db.Query(ctx, {{.ConstantName}}, {{.Arg.Params}})
+  	{{- else if $.EmitPreparedQueries}}
+	rows, err := q.query(ctx, {{template "queryStmt" .}}, {{template "queryString" .}}, {{.Arg.Params}})
   	{{- else}}
-	rows, err := q.db.QueryContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	rows, err := q.db.QueryContext(ctx, {{template "queryString" .}}, {{.Arg.Params}})
   	{{- end}}
 	if err != nil {
 		return nil, err
@@ -295,9 +563,11 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ([]{{.Ret.
 		}
 		items = append(items, {{.Ret.Name}})
 	}
+	{{- if not $.UsePgx}}
 	if err := rows.Close(); err != nil {
 		return nil, err
 	}
+	{{- end}}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -309,10 +579,15 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) ([]{{.Ret.
 {{range .Comments}}//{{.}}
 {{end -}}
 func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) error {
-  	{{- if $.EmitPreparedQueries}}
-	_, err := q.exec(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	{{- if .Arg.HasSqlcSlices}}
+	{{- template "sqlcSliceQuery" .}}
+	{{- end}}
+  	{{- if $.UsePgx}}
+	_, err := q.db.Exec(ctx, {{.ConstantName}}, {{.Arg.Params}})
+  	{{- else if $.EmitPreparedQueries}}
+	_, err := q.exec(ctx, {{template "queryStmt" .}}, {{template "queryString" .}}, {{.Arg.Params}})
   	{{- else}}
-	_, err := q.db.ExecContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	_, err := q.db.ExecContext(ctx, {{template "queryString" .}}, {{.Arg.Params}})
   	{{- end}}
 	return err
 }
@@ -322,41 +597,295 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) error {
 {{range .Comments}}//{{.}}
 {{end -}}
 func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, error) {
+	{{- if .Arg.HasSqlcSlices}}
+	{{- template "sqlcSliceQuery" .}}
+	{{- end}}
+  	{{- if $.UsePgx}}
+	result, err := q.db.Exec(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+  	{{- else}}
   	{{- if $.EmitPreparedQueries}}
-	result, err := q.exec(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	result, err := q.exec(ctx, {{template "queryStmt" .}}, {{template "queryString" .}}, {{.Arg.Params}})
   	{{- else}}
-	result, err := q.db.ExecContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	result, err := q.db.ExecContext(ctx, {{template "queryString" .}}, {{.Arg.Params}})
   	{{- end}}
 	if err != nil {
 		return 0, err
 	}
 	return result.RowsAffected()
+  	{{- end}}
 }
 {{end}}
 
-{{if eq .Cmd ":execresult"}}
+{{if and (eq .Cmd ":execresult") $.UsePgx}}
+{{range .Comments}}//{{.}}
+{{end -}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, {{.ConstantName}}, {{.Arg.Params}})
+}
+{{else if eq .Cmd ":execresult"}}
 {{range .Comments}}//{{.}}
 {{end -}}
 func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (sql.Result, error) {
+	{{- if .Arg.HasSqlcSlices}}
+	{{- template "sqlcSliceQuery" .}}
+	{{- end}}
   	{{- if $.EmitPreparedQueries}}
-	return q.exec(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+	return q.exec(ctx, {{template "queryStmt" .}}, {{template "queryString" .}}, {{.Arg.Params}})
   	{{- else}}
-	return q.db.ExecContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+	return q.db.ExecContext(ctx, {{template "queryString" .}}, {{.Arg.Params}})
   	{{- end}}
 }
 {{end}}
+
+{{if eq .Cmd ":copyfrom"}}
+{{range .Comments}}//{{.}}
+{{end -}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.SlicePair}}) (int64, error) {
+	{{- if $.UsePgx}}
+	return q.db.CopyFrom(ctx, {{.TableIdentifier}}, {{.CopyFromColumnList}}, pgx.CopyFromSlice(len({{.Arg.Name}}), func(i int) ([]interface{}, error) {
+		return []interface{}{ {{.Arg.ElemParams (printf "%s[i]" .Arg.Name)}} }, nil
+	}))
+	{{- else}}
+	// COPY only runs inside a transaction, so start one unless the queries
+	// already use one
+	if db, ok := q.db.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	}); ok {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+		count, err := q.WithTx(tx).{{.MethodName}}(ctx, {{.Arg.Name}})
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		return count, tx.Commit()
+	}
+	stmt, err := q.db.PrepareContext(ctx, {{.CopyInStatement}})
+	if err != nil {
+		return 0, err
+	}
+	for _, a := range {{.Arg.Name}} {
+		if _, err := stmt.ExecContext(ctx, {{.Arg.ElemParams "a"}}); err != nil {
+			stmt.Close()
+			return 0, err
+		}
+	}
+	result, err := stmt.ExecContext(ctx)
+	if err != nil {
+		stmt.Close()
+		return 0, err
+	}
+	if err := stmt.Close(); err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+	{{- end}}
+}
+{{end}}
+{{end}}
+{{end}}
+{{end}}
+
+{{define "queryString"}}{{if .Arg.HasSqlcSlices}}query{{else}}{{.ConstantName}}{{end}}{{end}}
+
+{{define "queryStmt"}}{{if .Arg.HasSqlcSlices}}nil{{else}}q.{{.FieldName}}{{end}}{{end}}
+
+{{define "sqlcSliceQuery"}}
+	query := {{.ConstantName}}
+	var queryParams []interface{}
+	{{- if .Arg.Struct}}
+	{{- $arg := .Arg.Name}}
+	{{- range .Arg.Bindings}}
+	{{- if .IsSqlcSlice}}
+	if len({{$arg}}.{{.Name}}) > 0 {
+		for _, v := range {{$arg}}.{{.Name}} {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "{{.SlicePlaceholder}}", strings.Repeat(",?", len({{$arg}}.{{.Name}}))[1:], 1)
+	} else {
+		query = strings.Replace(query, "{{.SlicePlaceholder}}", "NULL", 1)
+	}
+	{{- else}}
+	queryParams = append(queryParams, {{$arg}}.{{.Name}})
+	{{- end}}
+	{{- end}}
+	{{- else}}
+	{{- range .Arg.Uses}}
+	if len({{$.Arg.Name}}) > 0 {
+		for _, v := range {{$.Arg.Name}} {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "{{$.Arg.SlicePlaceholder}}", strings.Repeat(",?", len({{$.Arg.Name}}))[1:], 1)
+	} else {
+		query = strings.Replace(query, "{{$.Arg.SlicePlaceholder}}", "NULL", 1)
+	}
+	{{- end}}
+	{{- end}}
+{{- end}}
+
+{{define "batchFile"}}// Code generated by sqlc. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	{{range imports .SourceName}}
+	{{range .}}{{.}}
+	{{end}}
+	{{end}}
+)
+
+{{template "batchCode" . }}
+{{end}}
+
+{{define "batchCode"}}
+var (
+	ErrBatchAlreadyClosed = errors.New("batch already closed")
+)
+
+{{range .GoQueries}}
+{{if .IsBatch}}
+const {{.ConstantName}} = {{$.Q}}-- name: {{.MethodName}} {{.Cmd}}
+{{escape .SQL}}
+{{$.Q}}
+
+type {{.MethodName}}BatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+{{if .Arg.EmitStruct}}
+type {{.Arg.Type}} struct { {{- range .Arg.Struct.Fields}}
+  {{.Name}} {{.Type}} {{if or ($.EmitJSONTags) ($.EmitDBTags)}}{{$.Q}}{{.Tag}}{{$.Q}}{{end}}
+  {{- end}}
+}
+{{end}}
+
+{{if .Ret.EmitStruct}}
+type {{.Ret.Type}} struct { {{- range .Ret.Struct.Fields}}
+  {{.Name}} {{.Type}} {{if or ($.EmitJSONTags) ($.EmitDBTags)}}{{$.Q}}{{.Tag}}{{$.Q}}{{end}}
+  {{- end}}
+}
+{{end}}
+
+{{range .Comments}}//{{.}}
+{{end -}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.SlicePair}}) *{{.MethodName}}BatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range {{.Arg.Name}} {
+		vals := []interface{}{
+		{{- if .Arg.IsStruct}}
+		{{- range .Arg.Struct.Fields}}
+			a.{{.Name}},
+		{{- end}}
+		{{- else}}
+			a,
+		{{- end}}
+		}
+		batch.Queue({{.ConstantName}}, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &{{.MethodName}}BatchResults{br, len({{.Arg.Name}}), false}
+}
+
+{{if eq .Cmd ":batchexec"}}
+func (b *{{.MethodName}}BatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+{{end}}
+
+{{if eq .Cmd ":batchmany"}}
+func (b *{{.MethodName}}BatchResults) Query(f func(int, []{{.Ret.Type}}, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		{{- if $.EmitEmptySlices}}
+		items := []{{.Ret.Type}}{}
+		{{else}}
+		var items []{{.Ret.Type}}
+		{{end -}}
+		if b.closed {
+			if f != nil {
+				f(t, items, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		err := func() error {
+			rows, err := b.br.Query()
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var {{.Ret.Name}} {{.Ret.Type}}
+				if err := rows.Scan({{.Ret.Scan}}); err != nil {
+					return err
+				}
+				items = append(items, {{.Ret.Name}})
+			}
+			return rows.Err()
+		}()
+		if f != nil {
+			f(t, items, err)
+		}
+	}
+}
+{{end}}
+
+{{if eq .Cmd ":batchone"}}
+func (b *{{.MethodName}}BatchResults) QueryRow(f func(int, {{.Ret.Type}}, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var {{.Ret.Name}} {{.Ret.Type}}
+		if b.closed {
+			if f != nil {
+				f(t, {{.Ret.Name}}, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan({{.Ret.Scan}})
+		if f != nil {
+			f(t, {{.Ret.Name}}, err)
+		}
+	}
+}
+{{end}}
+
+func (b *{{.MethodName}}BatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
 {{end}}
 {{end}}
 {{end}}
 `
 
 type tmplCtx struct {
-	Q         string
-	Package   string
-	Enums     []Enum
-	Structs   []Struct
-	GoQueries []Query
-	Settings  config.Config
+	Q              string
+	Package        string
+	Enums          []Enum
+	CompositeTypes []Struct
+	Structs        []Struct
+	GoQueries      []Query
+	Settings       config.Config
 
 	// TODO: Race conditions
 	SourceName string
@@ -366,25 +895,52 @@ type tmplCtx struct {
 	EmitPreparedQueries bool
 	EmitInterface       bool
 	EmitEmptySlices     bool
+	UsePgx              bool
+	UsesBatch           bool
+	UsesCopyFrom        bool
 }
 
 func (t *tmplCtx) OutputQuery(sourceName string) bool {
 	return t.SourceName == sourceName
 }
 
+func usesCopyFrom(queries []Query) bool {
+	for _, q := range queries {
+		if q.Cmd == metadata.CmdCopyFrom {
+			return true
+		}
+	}
+	return false
+}
+
+func usesBatch(queries []Query) bool {
+	for _, q := range queries {
+		if q.IsBatch() {
+			return true
+		}
+	}
+	return false
+}
+
 func Generate(r *compiler.Result, settings config.CombinedSettings) (map[string]string, error) {
+	settings, err := withAnnotationTypes(r, settings)
+	if err != nil {
+		return nil, err
+	}
 	enums := buildEnums(r, settings)
+	composites := buildCompositeTypes(r, settings)
 	structs := buildStructs(r, settings)
 	queries := buildQueries(r, settings, structs)
-	return generate(settings, enums, structs, queries)
+	return generate(settings, enums, composites, structs, queries)
 }
 
-func generate(settings config.CombinedSettings, enums []Enum, structs []Struct, queries []Query) (map[string]string, error) {
+func generate(settings config.CombinedSettings, enums []Enum, composites, structs []Struct, queries []Query) (map[string]string, error) {
 	i := &importer{
-		Settings: settings,
-		Queries:  queries,
-		Enums:    enums,
-		Structs:  structs,
+		Settings:       settings,
+		Queries:        queries,
+		Enums:          enums,
+		CompositeTypes: composites,
+		Structs:        structs,
 	}
 
 	funcMap := template.FuncMap{
@@ -397,17 +953,23 @@ func generate(settings config.CombinedSettings, enums []Enum, structs []Struct,
 	tmpl := template.Must(template.New("table").Funcs(funcMap).Parse(templateSet))
 
 	golang := settings.Go
+	usePgx := golang.SQLPackage == config.SQLPackagePGX
 	tctx := tmplCtx{
-		Settings:            settings.Global,
-		EmitInterface:       golang.EmitInterface,
-		EmitJSONTags:        golang.EmitJSONTags,
-		EmitDBTags:          golang.EmitDBTags,
-		EmitPreparedQueries: golang.EmitPreparedQueries,
+		Settings:      settings.Global,
+		EmitInterface: golang.EmitInterface,
+		EmitJSONTags:  golang.EmitJSONTags,
+		EmitDBTags:    golang.EmitDBTags,
+		// pgx prepares and caches statements on its own
+		EmitPreparedQueries: golang.EmitPreparedQueries && !usePgx,
 		EmitEmptySlices:     golang.EmitEmptySlices,
+		UsePgx:              usePgx,
+		UsesBatch:           usesBatch(queries),
+		UsesCopyFrom:        usesCopyFrom(queries),
 		Q:                   "`",
 		Package:             golang.Package,
 		GoQueries:           queries,
 		Enums:               enums,
+		CompositeTypes:      composites,
 		Structs:             structs,
 	}
 
@@ -451,6 +1013,10 @@ func generate(settings config.CombinedSettings, enums []Enum, structs []Struct,
 	if golang.OutputQuerierFileName != "" {
 		querierFileName = golang.OutputQuerierFileName
 	}
+	batchFileName := "batch.go"
+	if golang.OutputBatchFileName != "" {
+		batchFileName = golang.OutputBatchFileName
+	}
 
 	if err := execute(dbFileName, "dbFile"); err != nil {
 		return nil, err
@@ -464,8 +1030,17 @@ func generate(settings config.CombinedSettings, enums []Enum, structs []Struct,
 		}
 	}
 
+	if tctx.UsesBatch {
+		if err := execute(batchFileName, "batchFile"); err != nil {
+			return nil, err
+		}
+	}
+
 	files := map[string]struct{}{}
 	for _, gq := range queries {
+		if gq.IsBatch() {
+			continue
+		}
 		files[gq.SourceName] = struct{}{}
 	}
 
@@ -1,6 +1,9 @@
 package golang
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/kyleconroy/sqlc/internal/compiler"
 	"github.com/kyleconroy/sqlc/internal/config"
 )
@@ -17,7 +20,7 @@ func goType(r *compiler.Result, col *compiler.Column, settings config.CombinedSe
 		}
 	}
 	typ := goInnerType(r, col, settings)
-	if col.IsArray {
+	if col.IsArray || col.IsSqlcSlice {
 		return "[]" + typ
 	}
 	return typ
@@ -49,3 +52,66 @@ func goInnerType(r *compiler.Result, col *compiler.Column, settings config.Combi
 		return "interface{}"
 	}
 }
+
+// goAnnotatedType returns the Go type that an @param or @column annotation
+// gives, which withAnnotationTypes has already validated
+func goAnnotatedType(spec string) string {
+	parsed, err := parseAnnotatedType(spec)
+	if err != nil {
+		return spec
+	}
+	return parsed.TypeName
+}
+
+// parseAnnotatedType parses the Go type of an annotation. Unlike the go_type
+// of an override, it may be a slice or a pointer, such as []string or
+// []*github.com/google/uuid.UUID.
+func parseAnnotatedType(spec string) (*config.ParsedGoType, error) {
+	elem := spec
+	for {
+		if strings.HasPrefix(elem, "[]") {
+			elem = elem[2:]
+		} else if strings.HasPrefix(elem, "*") {
+			elem = elem[1:]
+		} else {
+			break
+		}
+	}
+	parsed, err := config.GoType{Spec: elem}.Parse()
+	if err != nil {
+		return nil, err
+	}
+	parsed.TypeName = spec[:len(spec)-len(elem)] + parsed.TypeName
+	return parsed, nil
+}
+
+// withAnnotationTypes adds the types of the @param and @column annotations of
+// the queries to the overrides of the settings. They don't match any column or
+// database type, but let the importer find the packages of the types.
+func withAnnotationTypes(r *compiler.Result, settings config.CombinedSettings) (config.CombinedSettings, error) {
+	overrides := settings.Overrides[:len(settings.Overrides):len(settings.Overrides)]
+	for _, query := range r.Queries {
+		var specs []string
+		for _, spec := range query.ParamTypes {
+			specs = append(specs, spec)
+		}
+		for _, spec := range query.ColumnTypes {
+			specs = append(specs, spec)
+		}
+		for _, spec := range specs {
+			parsed, err := parseAnnotatedType(spec)
+			if err != nil {
+				return settings, fmt.Errorf("query %q has an invalid annotation type: %w", query.Name, err)
+			}
+			overrides = append(overrides, config.Override{
+				GoType:       config.GoType{Spec: spec},
+				GoImportPath: parsed.ImportPath,
+				GoPackage:    parsed.Package,
+				GoTypeName:   parsed.TypeName,
+				GoBasicType:  parsed.BasicType,
+			})
+		}
+	}
+	settings.Overrides = overrides
+	return settings, nil
+}
@@ -58,18 +58,21 @@ func mergeImports(imps ...fileImports) [][]ImportSpec {
 }
 
 type importer struct {
-	Settings config.CombinedSettings
-	Queries  []Query
-	Enums    []Enum
-	Structs  []Struct
+	Settings       config.CombinedSettings
+	Queries        []Query
+	Enums          []Enum
+	CompositeTypes []Struct
+	Structs        []Struct
 }
 
 func (i *importer) usesType(typ string) bool {
-	for _, strct := range i.Structs {
-		for _, f := range strct.Fields {
-			fType := strings.TrimPrefix(f.Type, "[]")
-			if strings.HasPrefix(fType, typ) {
-				return true
+	for _, structs := range [][]Struct{i.CompositeTypes, i.Structs} {
+		for _, strct := range structs {
+			for _, f := range strct.Fields {
+				fType := strings.TrimPrefix(f.Type, "[]")
+				if strings.HasPrefix(fType, typ) {
+					return true
+				}
 			}
 		}
 	}
@@ -100,6 +103,10 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 	if i.Settings.Go.OutputQuerierFileName != "" {
 		querierFileName = i.Settings.Go.OutputQuerierFileName
 	}
+	batchFileName := "batch.go"
+	if i.Settings.Go.OutputBatchFileName != "" {
+		batchFileName = i.Settings.Go.OutputBatchFileName
+	}
 
 	switch filename {
 	case dbFileName:
@@ -108,12 +115,27 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 		return mergeImports(i.modelImports())
 	case querierFileName:
 		return mergeImports(i.interfaceImports())
+	case batchFileName:
+		return mergeImports(i.batchImports())
 	default:
 		return mergeImports(i.queryImports(filename))
 	}
 }
 
+func (i *importer) usesPgx() bool {
+	return i.Settings.Go.SQLPackage == config.SQLPackagePGX
+}
+
 func (i *importer) dbImports() fileImports {
+	if i.usesPgx() {
+		return fileImports{
+			Std: []ImportSpec{{Path: "context"}},
+			Dep: []ImportSpec{
+				{Path: "github.com/jackc/pgconn"},
+				{Path: "github.com/jackc/pgx/v4"},
+			},
+		}
+	}
 	std := []ImportSpec{
 		{Path: "context"},
 		{Path: "database/sql"},
@@ -127,6 +149,7 @@ func (i *importer) dbImports() fileImports {
 var stdlibTypes = map[string]string{
 	"json.RawMessage":  "encoding/json",
 	"time.Time":        "time",
+	"time.Duration":    "time",
 	"net.IP":           "net",
 	"net.HardwareAddr": "net",
 }
@@ -140,7 +163,7 @@ func (i *importer) interfaceImports() fileImports {
 				}
 			}
 			if !q.Arg.isEmpty() {
-				if strings.HasPrefix(q.Arg.Type(), name) {
+				if strings.HasPrefix(strings.TrimPrefix(q.Arg.Type(), "[]"), name) {
 					return true
 				}
 			}
@@ -154,9 +177,14 @@ func (i *importer) interfaceImports() fileImports {
 	if uses("sql.Null") {
 		std["database/sql"] = struct{}{}
 	}
+	pkg := make(map[ImportSpec]struct{})
 	for _, q := range i.Queries {
 		if q.Cmd == metadata.CmdExecResult {
-			std["database/sql"] = struct{}{}
+			if i.usesPgx() {
+				pkg[ImportSpec{Path: "github.com/jackc/pgconn"}] = struct{}{}
+			} else {
+				std["database/sql"] = struct{}{}
+			}
 		}
 	}
 	for typeName, pkg := range stdlibTypes {
@@ -165,7 +193,6 @@ func (i *importer) interfaceImports() fileImports {
 		}
 	}
 
-	pkg := make(map[ImportSpec]struct{})
 	overrideTypes := map[string]string{}
 	for _, o := range i.Settings.Overrides {
 		if o.GoBasicType || o.GoTypeName == "" {
@@ -174,6 +201,9 @@ func (i *importer) interfaceImports() fileImports {
 		overrideTypes[o.GoTypeName] = o.GoImportPath
 	}
 
+	if uses("pgtype.") {
+		pkg[ImportSpec{Path: "github.com/jackc/pgtype"}] = struct{}{}
+	}
 	_, overrideNullTime := overrideTypes["pq.NullTime"]
 	if uses("pq.NullTime") && !overrideNullTime {
 		pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
@@ -223,6 +253,12 @@ func (i *importer) modelImports() fileImports {
 	if len(i.Enums) > 0 {
 		std["fmt"] = struct{}{}
 	}
+	// Composite types are scanned from and encoded to row literals
+	if len(i.CompositeTypes) > 0 {
+		for _, path := range []string{"database/sql", "database/sql/driver", "encoding/hex", "fmt", "strconv", "strings", "time"} {
+			std[path] = struct{}{}
+		}
+	}
 
 	// Custom imports
 	pkg := make(map[ImportSpec]struct{})
@@ -234,6 +270,9 @@ func (i *importer) modelImports() fileImports {
 		overrideTypes[o.GoTypeName] = o.GoImportPath
 	}
 
+	if i.usesType("pgtype.") {
+		pkg[ImportSpec{Path: "github.com/jackc/pgtype"}] = struct{}{}
+	}
 	_, overrideNullTime := overrideTypes["pq.NullTime"]
 	if i.usesType("pq.NullTime") && !overrideNullTime {
 		pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
@@ -273,10 +312,38 @@ func (i *importer) modelImports() fileImports {
 func (i *importer) queryImports(filename string) fileImports {
 	var gq []Query
 	for _, query := range i.Queries {
-		if query.SourceName == filename {
+		if query.SourceName == filename && !query.IsBatch() {
+			gq = append(gq, query)
+		}
+	}
+	return i.importsForQueries(gq)
+}
+
+func (i *importer) batchImports() fileImports {
+	var gq []Query
+	for _, query := range i.Queries {
+		if query.IsBatch() {
 			gq = append(gq, query)
 		}
 	}
+	imports := i.importsForQueries(gq)
+	imports.Std = appendImport(imports.Std, ImportSpec{Path: "errors"})
+	imports.Dep = appendImport(imports.Dep, ImportSpec{Path: "github.com/jackc/pgx/v4"})
+	return imports
+}
+
+func appendImport(specs []ImportSpec, spec ImportSpec) []ImportSpec {
+	for _, s := range specs {
+		if s == spec {
+			return specs
+		}
+	}
+	specs = append(specs, spec)
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Path < specs[j].Path })
+	return specs
+}
+
+func (i *importer) importsForQueries(gq []Query) fileImports {
 
 	uses := func(name string) bool {
 		for _, q := range gq {
@@ -302,7 +369,7 @@ func (i *importer) queryImports(filename string) fileImports {
 						}
 					}
 				}
-				if strings.HasPrefix(q.Arg.Type(), name) {
+				if strings.HasPrefix(strings.TrimPrefix(q.Arg.Type(), "[]"), name) {
 					return true
 				}
 			}
@@ -328,12 +395,12 @@ func (i *importer) queryImports(filename string) fileImports {
 			if !q.Arg.isEmpty() {
 				if q.Arg.IsStruct() {
 					for _, f := range q.Arg.Struct.Fields {
-						if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
+						if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !f.IsSqlcSlice() {
 							return true
 						}
 					}
 				} else {
-					if strings.HasPrefix(q.Arg.Type(), "[]") && q.Arg.Type() != "[]byte" {
+					if strings.HasPrefix(q.Arg.Type(), "[]") && q.Arg.Type() != "[]byte" && !q.Arg.IsSqlcSlice() {
 						return true
 					}
 				}
@@ -348,9 +415,25 @@ func (i *importer) queryImports(filename string) fileImports {
 	if uses("sql.Null") {
 		std["database/sql"] = struct{}{}
 	}
+	pkg := make(map[ImportSpec]struct{})
 	for _, q := range gq {
 		if q.Cmd == metadata.CmdExecResult {
-			std["database/sql"] = struct{}{}
+			if i.usesPgx() {
+				pkg[ImportSpec{Path: "github.com/jackc/pgconn"}] = struct{}{}
+			} else {
+				std["database/sql"] = struct{}{}
+			}
+		}
+		if q.Arg.HasSqlcSlices() {
+			std["strings"] = struct{}{}
+		}
+		if q.Cmd == metadata.CmdCopyFrom {
+			if i.usesPgx() {
+				pkg[ImportSpec{Path: "github.com/jackc/pgx/v4"}] = struct{}{}
+			} else {
+				std["database/sql"] = struct{}{}
+				pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
+			}
 		}
 	}
 	for typeName, pkg := range stdlibTypes {
@@ -359,7 +442,6 @@ func (i *importer) queryImports(filename string) fileImports {
 		}
 	}
 
-	pkg := make(map[ImportSpec]struct{})
 	overrideTypes := map[string]string{}
 	for _, o := range i.Settings.Overrides {
 		if o.GoBasicType || o.GoTypeName == "" {
@@ -368,9 +450,12 @@ func (i *importer) queryImports(filename string) fileImports {
 		overrideTypes[o.GoTypeName] = o.GoImportPath
 	}
 
-	if sliceScan() {
+	if sliceScan() && !i.usesPgx() {
 		pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
 	}
+	if uses("pgtype.") {
+		pkg[ImportSpec{Path: "github.com/jackc/pgtype"}] = struct{}{}
+	}
 	_, overrideNullTime := overrideTypes["pq.NullTime"]
 	if uses("pq.NullTime") && !overrideNullTime {
 		pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
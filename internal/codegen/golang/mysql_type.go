@@ -55,7 +55,7 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 		}
 		return "sql.NullFloat64"
 
-	case "decimal", "dec", "fixed":
+	case "decimal", "dec", "fixed", "numeric":
 		if notNull {
 			return "string"
 		}
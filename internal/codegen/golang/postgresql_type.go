@@ -12,51 +12,81 @@ import (
 func postgresType(r *compiler.Result, col *compiler.Column, settings config.CombinedSettings) string {
 	columnType := col.DataType
 	notNull := col.NotNull || col.IsArray
+	pgx := settings.Go.SQLPackage == config.SQLPackagePGX
 
 	switch columnType {
 	case "serial", "serial4", "pg_catalog.serial4":
 		if notNull {
 			return "int32"
 		}
+		if pgx {
+			return "pgtype.Int4"
+		}
 		return "sql.NullInt32"
 
 	case "bigserial", "serial8", "pg_catalog.serial8":
 		if notNull {
 			return "int64"
 		}
+		if pgx {
+			return "pgtype.Int8"
+		}
 		return "sql.NullInt64"
 
 	case "smallserial", "serial2", "pg_catalog.serial2":
+		if !notNull && pgx {
+			return "pgtype.Int2"
+		}
 		return "int16"
 
 	case "integer", "int", "int4", "pg_catalog.int4":
 		if notNull {
 			return "int32"
 		}
+		if pgx {
+			return "pgtype.Int4"
+		}
 		return "sql.NullInt32"
 
 	case "bigint", "int8", "pg_catalog.int8":
 		if notNull {
 			return "int64"
 		}
+		if pgx {
+			return "pgtype.Int8"
+		}
 		return "sql.NullInt64"
 
 	case "smallint", "int2", "pg_catalog.int2":
+		if !notNull && pgx {
+			return "pgtype.Int2"
+		}
 		return "int16"
 
 	case "float", "double precision", "float8", "pg_catalog.float8":
 		if notNull {
 			return "float64"
 		}
+		if pgx {
+			return "pgtype.Float8"
+		}
 		return "sql.NullFloat64"
 
 	case "real", "float4", "pg_catalog.float4":
 		if notNull {
 			return "float32"
 		}
+		if pgx {
+			return "pgtype.Float4"
+		}
 		return "sql.NullFloat64" // TODO: Change to sql.NullFloat32 after updating the go.mod file
 
 	case "numeric", "pg_catalog.numeric", "money":
+		// pgx can't scan a numeric into a string, so always use its own
+		// decimal representation.
+		if pgx {
+			return "pgtype.Numeric"
+		}
 		// Since the Go standard library does not have a decimal type, lib/pq
 		// returns numerics as strings.
 		//
@@ -70,9 +100,21 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		if notNull {
 			return "bool"
 		}
+		if pgx {
+			return "pgtype.Bool"
+		}
 		return "sql.NullBool"
 
-	case "json", "jsonb":
+	case "json":
+		if !notNull && pgx {
+			return "pgtype.JSON"
+		}
+		return "json.RawMessage"
+
+	case "jsonb":
+		if !notNull && pgx {
+			return "pgtype.JSONB"
+		}
 		return "json.RawMessage"
 
 	case "bytea", "blob", "pg_catalog.bytea":
@@ -82,33 +124,63 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		if notNull {
 			return "time.Time"
 		}
+		if pgx {
+			return "pgtype.Date"
+		}
 		return "sql.NullTime"
 
-	case "pg_catalog.time", "pg_catalog.timetz":
+	case "pg_catalog.time", "pg_catalog.timetz", "time without time zone", "time with time zone":
 		if notNull {
 			return "time.Time"
 		}
+		if pgx {
+			return "pgtype.Time"
+		}
 		return "sql.NullTime"
 
-	case "pg_catalog.timestamp", "pg_catalog.timestamptz", "timestamptz":
+	case "pg_catalog.timestamp", "timestamp without time zone":
 		if notNull {
 			return "time.Time"
 		}
+		if pgx {
+			return "pgtype.Timestamp"
+		}
 		return "sql.NullTime"
 
-	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string":
+	case "pg_catalog.timestamptz", "timestamptz", "timestamp with time zone":
+		if notNull {
+			return "time.Time"
+		}
+		if pgx {
+			return "pgtype.Timestamptz"
+		}
+		return "sql.NullTime"
+
+	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string", "character varying", "character":
 		if notNull {
 			return "string"
 		}
+		if pgx {
+			return "pgtype.Text"
+		}
 		return "sql.NullString"
 
 	case "uuid":
+		if !notNull && pgx {
+			return "pgtype.UUID"
+		}
 		return "uuid.UUID"
 
 	case "inet", "cidr":
+		if !notNull && pgx {
+			return "pgtype.Inet"
+		}
 		return "net.IP"
 
 	case "macaddr", "macaddr8":
+		if !notNull && pgx {
+			return "pgtype.Macaddr"
+		}
 		return "net.HardwareAddr"
 
 	case "ltree", "lquery", "ltxtquery":
@@ -120,9 +192,18 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		if notNull {
 			return "string"
 		}
+		if pgx {
+			return "pgtype.Text"
+		}
 		return "sql.NullString"
 
 	case "interval", "pg_catalog.interval":
+		if pgx {
+			if notNull {
+				return "time.Duration"
+			}
+			return "pgtype.Interval"
+		}
 		if notNull {
 			return "int64"
 		}
@@ -158,11 +239,31 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 						}
 						return StructName(schema.Name+"_"+t.Name, settings)
 					}
+				case *catalog.Domain:
+					if rel.Name == t.Name && rel.Schema == schema.Name {
+						base := *col
+						base.DataType = t.BaseType.Name
+						if t.BaseType.Schema != "" {
+							base.DataType = t.BaseType.Schema + "." + t.BaseType.Name
+						}
+						base.NotNull = col.NotNull || t.IsNotNull
+						if t.IsArray {
+							base.IsArray = true
+							return "[]" + goInnerType(r, &base, settings)
+						}
+						return goInnerType(r, &base, settings)
+					}
 				case *catalog.CompositeType:
-					if notNull {
-						return "string"
+					if rel.Name == t.Name && rel.Schema == schema.Name {
+						name := t.Name
+						if schema.Name != r.Catalog.DefaultSchema {
+							name = schema.Name + "_" + t.Name
+						}
+						if notNull {
+							return StructName(name, settings)
+						}
+						return "Null" + StructName(name, settings)
 					}
-					return "sql.NullString"
 				}
 			}
 		}
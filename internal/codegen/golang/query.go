@@ -1,16 +1,29 @@
 package golang
 
 import (
+	"strconv"
 	"strings"
 
+	"github.com/kyleconroy/sqlc/internal/compiler"
+	"github.com/kyleconroy/sqlc/internal/config"
 	"github.com/kyleconroy/sqlc/internal/metadata"
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/named"
 )
 
 type QueryValue struct {
-	Emit   bool
-	Name   string
-	Struct *Struct
-	Typ    string
+	Emit       bool
+	Name       string
+	Struct     *Struct
+	Typ        string
+	SQLPackage string
+
+	// Column is only set for values built from a single query parameter
+	Column *compiler.Column
+
+	// The positions of the parameters bound to each placeholder, if a
+	// parameter is bound more than once
+	bindings []int
 }
 
 func (v QueryValue) EmitStruct() bool {
@@ -32,6 +45,13 @@ func (v QueryValue) Pair() string {
 	return v.Name + " " + v.Type()
 }
 
+func (v QueryValue) SlicePair() string {
+	if v.isEmpty() {
+		return ""
+	}
+	return v.Name + " []" + v.Type()
+}
+
 func (v QueryValue) Type() string {
 	if v.Typ != "" {
 		return v.Typ
@@ -42,23 +62,88 @@ func (v QueryValue) Type() string {
 	panic("no type for QueryValue: " + v.Name)
 }
 
+// pgx encodes and decodes slices natively, while database/sql needs them
+// wrapped with pq.Array
+func (v QueryValue) wrapArray(typ string) bool {
+	return strings.HasPrefix(typ, "[]") && typ != "[]byte" && v.SQLPackage != config.SQLPackagePGX
+}
+
+// Queries with sqlc.slice parameters build their argument list at runtime
 func (v QueryValue) Params() string {
+	if v.HasSqlcSlices() {
+		return "queryParams..."
+	}
+	return v.params(v.Name)
+}
+
+func (v QueryValue) IsSqlcSlice() bool {
+	return v.Column != nil && v.Column.IsSqlcSlice
+}
+
+func (v QueryValue) SlicePlaceholder() string {
+	return named.SlicePlaceholder(v.Column.Name)
+}
+
+func (v QueryValue) HasSqlcSlices() bool {
+	if v.Struct == nil {
+		return v.IsSqlcSlice()
+	}
+	for _, f := range v.Struct.Fields {
+		if f.IsSqlcSlice() {
+			return true
+		}
+	}
+	return false
+}
+
+// ElemParams is like Params, but reads the values from a single element of a
+// slice argument
+func (v QueryValue) ElemParams(elem string) string {
+	return v.params(elem)
+}
+
+// Bindings returns the fields of a struct in the order that they're bound to
+// the placeholders of the query. A field is repeated if it's bound more than
+// once.
+func (v QueryValue) Bindings() []Field {
+	if len(v.bindings) == 0 {
+		return v.Struct.Fields
+	}
+	fields := make([]Field, 0, len(v.bindings))
+	for _, i := range v.bindings {
+		fields = append(fields, v.Struct.Fields[i])
+	}
+	return fields
+}
+
+// Uses returns an element for each placeholder that a single value is bound to
+func (v QueryValue) Uses() []int {
+	if len(v.bindings) == 0 {
+		return []int{0}
+	}
+	return v.bindings
+}
+
+func (v QueryValue) params(name string) string {
 	if v.isEmpty() {
 		return ""
 	}
 	var out []string
 	if v.Struct == nil {
-		if strings.HasPrefix(v.Typ, "[]") && v.Typ != "[]byte" {
-			out = append(out, "pq.Array("+v.Name+")")
-		} else {
-			out = append(out, v.Name)
+		value := name
+		if v.wrapArray(v.Typ) {
+			value = "pq.Array(" + name + ")"
+		}
+		out = append(out, value)
+		for i := 1; i < len(v.bindings); i++ {
+			out = append(out, value)
 		}
 	} else {
-		for _, f := range v.Struct.Fields {
-			if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
-				out = append(out, "pq.Array("+v.Name+"."+f.Name+")")
+		for _, f := range v.Bindings() {
+			if v.wrapArray(f.Type) {
+				out = append(out, "pq.Array("+name+"."+f.Name+")")
 			} else {
-				out = append(out, v.Name+"."+f.Name)
+				out = append(out, name+"."+f.Name)
 			}
 		}
 	}
@@ -72,14 +157,25 @@ func (v QueryValue) Params() string {
 func (v QueryValue) Scan() string {
 	var out []string
 	if v.Struct == nil {
-		if strings.HasPrefix(v.Typ, "[]") && v.Typ != "[]byte" {
+		if v.wrapArray(v.Typ) {
 			out = append(out, "pq.Array(&"+v.Name+")")
 		} else {
 			out = append(out, "&"+v.Name)
 		}
 	} else {
 		for _, f := range v.Struct.Fields {
-			if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
+			// Scan into the fields of an embedded model struct
+			if len(f.EmbedFields) > 0 {
+				for _, embed := range f.EmbedFields {
+					if v.wrapArray(embed.Type) {
+						out = append(out, "pq.Array(&"+v.Name+"."+f.Name+"."+embed.Name+")")
+					} else {
+						out = append(out, "&"+v.Name+"."+f.Name+"."+embed.Name)
+					}
+				}
+				continue
+			}
+			if v.wrapArray(f.Type) {
 				out = append(out, "pq.Array(&"+v.Name+"."+f.Name+")")
 			} else {
 				out = append(out, "&"+v.Name+"."+f.Name)
@@ -104,9 +200,49 @@ type Query struct {
 	SourceName   string
 	Ret          QueryValue
 	Arg          QueryValue
+
+	// Used by :copyfrom
+	Table           *ast.TableName
+	CopyFromColumns []string
 }
 
 func (q Query) hasRetType() bool {
-	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany
+	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany ||
+		q.Cmd == metadata.CmdBatchMany || q.Cmd == metadata.CmdBatchOne
 	return scanned && !q.Ret.isEmpty()
 }
+
+func (q Query) IsBatch() bool {
+	return metadata.IsBatch(q.Cmd)
+}
+
+func (q Query) tableParts() []string {
+	if q.Table.Schema != "" {
+		return []string{q.Table.Schema, q.Table.Name}
+	}
+	return []string{q.Table.Name}
+}
+
+func quoteAll(names []string) []string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	return quoted
+}
+
+func (q Query) TableIdentifier() string {
+	return "pgx.Identifier{" + strings.Join(quoteAll(q.tableParts()), ", ") + "}"
+}
+
+func (q Query) CopyFromColumnList() string {
+	return "[]string{" + strings.Join(quoteAll(q.CopyFromColumns), ", ") + "}"
+}
+
+func (q Query) CopyInStatement() string {
+	args := quoteAll(append(q.tableParts(), q.CopyFromColumns...))
+	if q.Table.Schema != "" {
+		return "pq.CopyInSchema(" + strings.Join(args, ", ") + ")"
+	}
+	return "pq.CopyIn(" + strings.Join(args, ", ") + ")"
+}
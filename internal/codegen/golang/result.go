@@ -10,6 +10,8 @@ import (
 	"github.com/kyleconroy/sqlc/internal/config"
 	"github.com/kyleconroy/sqlc/internal/core"
 	"github.com/kyleconroy/sqlc/internal/inflection"
+	"github.com/kyleconroy/sqlc/internal/metadata"
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 )
 
@@ -56,6 +58,50 @@ func buildEnums(r *compiler.Result, settings config.CombinedSettings) []Enum {
 	return enums
 }
 
+func buildCompositeTypes(r *compiler.Result, settings config.CombinedSettings) []Struct {
+	var structs []Struct
+	for _, schema := range r.Catalog.Schemas {
+		if schema.Name == "pg_catalog" {
+			continue
+		}
+		for _, typ := range schema.Types {
+			ct, ok := typ.(*catalog.CompositeType)
+			if !ok {
+				continue
+			}
+			var typeName string
+			if schema.Name == r.Catalog.DefaultSchema {
+				typeName = ct.Name
+			} else {
+				typeName = schema.Name + "_" + ct.Name
+			}
+			s := Struct{
+				Name:    StructName(typeName, settings),
+				Comment: ct.Comment,
+			}
+			for _, column := range ct.Columns {
+				tags := map[string]string{}
+				if settings.Go.EmitDBTags {
+					tags["db:"] = column.Name
+				}
+				if settings.Go.EmitJSONTags {
+					tags["json:"] = JSONTagName(column.Name, settings)
+				}
+				s.Fields = append(s.Fields, Field{
+					Name: StructName(column.Name, settings),
+					Type: goType(r, compiler.ConvertColumn(nil, column), settings),
+					Tags: tags,
+				})
+			}
+			structs = append(structs, s)
+		}
+	}
+	if len(structs) > 0 {
+		sort.Slice(structs, func(i, j int) bool { return structs[i].Name < structs[j].Name })
+	}
+	return structs
+}
+
 func buildStructs(r *compiler.Result, settings config.CombinedSettings) []Struct {
 	var structs []Struct
 	for _, schema := range r.Catalog.Schemas {
@@ -63,6 +109,9 @@ func buildStructs(r *compiler.Result, settings config.CombinedSettings) []Struct
 			continue
 		}
 		for _, table := range schema.Tables {
+			if settings.Go.OmitPartitionModels && table.IsPartition() {
+				continue
+			}
 			var tableName string
 			if schema.Name == r.Catalog.DefaultSchema {
 				tableName = table.Rel.Name
@@ -90,7 +139,7 @@ func buildStructs(r *compiler.Result, settings config.CombinedSettings) []Struct
 					Name:    StructName(column.Name, settings),
 					Type:    goType(r, compiler.ConvertColumn(table.Rel, column), settings),
 					Tags:    tags,
-					Comment: column.Comment,
+					Comment: codegen.ColumnComment(column),
 				})
 			}
 			structs = append(structs, s)
@@ -105,6 +154,34 @@ func buildStructs(r *compiler.Result, settings config.CombinedSettings) []Struct
 type goColumn struct {
 	id int
 	*compiler.Column
+	embed *goEmbed
+	// typ is the type given by an annotation of the query, if any
+	typ string
+}
+
+type goEmbed struct {
+	modelType string
+	modelName string
+	fields    []Field
+}
+
+// look through all the structs and attempt to find a matching one to embed
+// We need the name of the struct and its field names.
+func newGoEmbed(embed *ast.TableName, structs []Struct, defaultSchema string) *goEmbed {
+	if embed == nil {
+		return nil
+	}
+	for _, s := range structs {
+		if !sameTableName(embed, s.Table, defaultSchema) {
+			continue
+		}
+		return &goEmbed{
+			modelType: s.Name,
+			modelName: s.Name,
+			fields:    s.Fields,
+		}
+	}
+	return nil
 }
 
 func columnName(c *compiler.Column, pos int) string {
@@ -155,11 +232,27 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 			Comments:     query.Comments,
 		}
 
+		paramType := func(p compiler.Parameter) string {
+			if spec, ok := query.ParamTypes[p.Number]; ok {
+				return goAnnotatedType(spec)
+			}
+			return goType(r, p.Column, settings)
+		}
+		columnType := func(i int) string {
+			if spec, ok := query.ColumnTypes[i]; ok {
+				return goAnnotatedType(spec)
+			}
+			return goType(r, query.Columns[i], settings)
+		}
+
 		if len(query.Params) == 1 {
 			p := query.Params[0]
 			gq.Arg = QueryValue{
-				Name: paramName(p),
-				Typ:  goType(r, p.Column, settings),
+				Name:       paramName(p),
+				Typ:        paramType(p),
+				SQLPackage: settings.Go.SQLPackage,
+				Column:     p.Column,
+				bindings:   paramBindings(query),
 			}
 		} else if len(query.Params) > 1 {
 			var cols []goColumn
@@ -167,26 +260,40 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 				cols = append(cols, goColumn{
 					id:     p.Number,
 					Column: p.Column,
+					typ:    query.ParamTypes[p.Number],
 				})
 			}
 			gq.Arg = QueryValue{
-				Emit:   true,
-				Name:   "arg",
-				Struct: columnsToStruct(r, gq.MethodName+"Params", cols, settings),
+				Emit:       true,
+				Name:       "arg",
+				Struct:     columnsToStruct(r, gq.MethodName+"Params", cols, settings),
+				SQLPackage: settings.Go.SQLPackage,
+				bindings:   paramBindings(query),
 			}
 		}
 
-		if len(query.Columns) == 1 {
+		if query.Cmd == metadata.CmdCopyFrom {
+			gq.Table = query.InsertIntoTable
+			for _, p := range query.Params {
+				gq.CopyFromColumns = append(gq.CopyFromColumns, p.Column.OriginalName)
+			}
+		}
+
+		if len(query.Columns) == 1 && query.Columns[0].EmbedTable == nil {
 			c := query.Columns[0]
 			gq.Ret = QueryValue{
-				Name: columnName(c, 0),
-				Typ:  goType(r, c, settings),
+				Name:       columnName(c, 0),
+				Typ:        columnType(0),
+				SQLPackage: settings.Go.SQLPackage,
 			}
-		} else if len(query.Columns) > 1 {
+		} else if len(query.Columns) >= 1 {
 			var gs *Struct
 			var emit bool
 
 			for _, s := range structs {
+				if hasEmbeds(query.Columns) {
+					break
+				}
 				if len(s.Fields) != len(query.Columns) {
 					continue
 				}
@@ -194,8 +301,8 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 				for i, f := range s.Fields {
 					c := query.Columns[i]
 					sameName := f.Name == StructName(columnName(c, i), settings)
-					sameType := f.Type == goType(r, c, settings)
-					sameTable := sameTableName(c.Table, s.Table, r.Catalog.DefaultSchema)
+					sameType := f.Type == columnType(i)
+					sameTable := sameTableName(modelTable(r, c.Table, settings), s.Table, r.Catalog.DefaultSchema)
 					if !sameName || !sameType || !sameTable {
 						same = false
 					}
@@ -212,15 +319,18 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 					columns = append(columns, goColumn{
 						id:     i,
 						Column: c,
+						embed:  newGoEmbed(modelTable(r, c.EmbedTable, settings), structs, r.Catalog.DefaultSchema),
+						typ:    query.ColumnTypes[i],
 					})
 				}
 				gs = columnsToStruct(r, gq.MethodName+"Row", columns, settings)
 				emit = true
 			}
 			gq.Ret = QueryValue{
-				Emit:   emit,
-				Name:   "i",
-				Struct: gs,
+				Emit:       emit,
+				Name:       "i",
+				Struct:     gs,
+				SQLPackage: settings.Go.SQLPackage,
 			}
 		}
 
@@ -230,6 +340,15 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 	return qs
 }
 
+func hasEmbeds(columns []*compiler.Column) bool {
+	for _, c := range columns {
+		if c.EmbedTable != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // It's possible that this method will generate duplicate JSON tag values
 //
 //   Columns: count, count,   count_2
@@ -237,6 +356,23 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 // JSON tags: count, count_2, count_2
 //
 // This is unlikely to happen, so don't fix it yet
+// paramBindings returns the position of the parameter that each placeholder
+// of a query is bound to, if a parameter is bound more than once
+func paramBindings(query *compiler.Query) []int {
+	if len(query.Placeholders) == 0 {
+		return nil
+	}
+	index := map[int]int{}
+	for i, p := range query.Params {
+		index[p.Number] = i
+	}
+	bindings := make([]int, 0, len(query.Placeholders))
+	for _, num := range query.Placeholders {
+		bindings = append(bindings, index[num])
+	}
+	return bindings
+}
+
 func columnsToStruct(r *compiler.Result, name string, columns []goColumn, settings config.CombinedSettings) *Struct {
 	gs := Struct{
 		Name: name,
@@ -247,6 +383,9 @@ func columnsToStruct(r *compiler.Result, name string, columns []goColumn, settin
 		colName := columnName(c.Column, i)
 		tagName := colName
 		fieldName := StructName(colName, settings)
+		if c.embed != nil {
+			fieldName = c.embed.modelName
+		}
 		// Track suffixes by the ID of the column, so that columns referring to the same numbered parameter can be
 		// reused.
 		suffix := 0
@@ -267,12 +406,36 @@ func columnsToStruct(r *compiler.Result, name string, columns []goColumn, settin
 		if settings.Go.EmitJSONTags {
 			tags["json:"] = JSONTagName(tagName, settings)
 		}
-		gs.Fields = append(gs.Fields, Field{
-			Name: fieldName,
-			Type: goType(r, c.Column, settings),
-			Tags: tags,
-		})
+		if c.embed != nil {
+			gs.Fields = append(gs.Fields, Field{
+				Name:        fieldName,
+				Type:        c.embed.modelType,
+				Tags:        tags,
+				Column:      c.Column,
+				EmbedFields: c.embed.fields,
+			})
+		} else {
+			typ := goType(r, c.Column, settings)
+			if c.typ != "" {
+				typ = goAnnotatedType(c.typ)
+			}
+			gs.Fields = append(gs.Fields, Field{
+				Name:   fieldName,
+				Type:   typ,
+				Tags:   tags,
+				Column: c.Column,
+			})
+		}
 		seen[colName]++
 	}
 	return &gs
 }
+
+// modelTable returns the table whose model a column belongs to. Without
+// models of their own, partitions use the model of their partitioned table.
+func modelTable(r *compiler.Result, table *ast.TableName, settings config.CombinedSettings) *ast.TableName {
+	if settings.Go.OmitPartitionModels {
+		return r.Catalog.PartitionRoot(table)
+	}
+	return table
+}
@@ -47,6 +47,8 @@ type Field struct {
 	Name    string
 	Type    ktType
 	Comment string
+	// EmbedFields are the fields of the model class for sqlc.embed()
+	EmbedFields []Field
 }
 
 type Struct struct {
@@ -88,6 +90,9 @@ func (v QueryValue) Type() string {
 }
 
 func jdbcSet(t ktType, idx int, name string) string {
+	if t.Import != "" {
+		return fmt.Sprintf("stmt.setObject(%d, %s)", idx, name)
+	}
 	if t.IsEnum && t.IsArray {
 		return fmt.Sprintf(`stmt.setArray(%d, conn.createArrayOf("%s", %s.map { v -> v.value }.toTypedArray()))`, idx, t.DataType, name)
 	}
@@ -144,6 +149,9 @@ func (v Params) Bindings() string {
 }
 
 func jdbcGet(t ktType, idx int) string {
+	if t.Import != "" {
+		return fmt.Sprintf(`results.getObject(%d, %s::class.java)`, idx, t.Name)
+	}
 	if t.IsEnum && t.IsArray {
 		return fmt.Sprintf(`(results.getArray(%d).array as Array<String>).map { v -> %s.lookup(v)!! }.toList()`, idx, t.Name)
 	}
@@ -167,8 +175,19 @@ func (v QueryValue) ResultSet() string {
 	if v.Struct == nil {
 		return jdbcGet(v.Typ, 1)
 	}
-	for i, f := range v.Struct.Fields {
-		out = append(out, jdbcGet(f.Type, i+1))
+	idx := 1
+	for _, f := range v.Struct.Fields {
+		if len(f.EmbedFields) > 0 {
+			var inner []string
+			for _, embed := range f.EmbedFields {
+				inner = append(inner, jdbcGet(embed.Type, idx))
+				idx++
+			}
+			out = append(out, f.Type.Name+"(\n"+indent(strings.Join(inner, ",\n"), 4, -1)+"\n)")
+			continue
+		}
+		out = append(out, jdbcGet(f.Type, idx))
+		idx++
 	}
 	ret := indent(strings.Join(out, ",\n"), 4, -1)
 	ret = indent(v.Struct.Name+"(\n"+ret+"\n)", 12, 0)
@@ -275,6 +294,9 @@ func buildDataClasses(r *compiler.Result, settings config.CombinedSettings) []St
 			continue
 		}
 		for _, table := range schema.Tables {
+			if settings.Kotlin.OmitPartitionModels && table.IsPartition() {
+				continue
+			}
 			var tableName string
 			if schema.Name == r.Catalog.DefaultSchema {
 				tableName = table.Rel.Name
@@ -294,7 +316,7 @@ func buildDataClasses(r *compiler.Result, settings config.CombinedSettings) []St
 				s.Fields = append(s.Fields, Field{
 					Name:    MemberName(column.Name, settings),
 					Type:    makeType(r, compiler.ConvertColumn(table.Rel, column), settings),
-					Comment: column.Comment,
+					Comment: codegen.ColumnComment(column),
 				})
 			}
 			structs = append(structs, s)
@@ -313,6 +335,8 @@ type ktType struct {
 	IsNull   bool
 	DataType string
 	Engine   config.Engine
+	// Import is the fully qualified name of a class given by an annotation
+	Import string
 }
 
 func (t ktType) String() string {
@@ -333,7 +357,7 @@ func (t ktType) jdbcType() string {
 	if t.IsArray {
 		return "Array"
 	}
-	if t.IsEnum || t.IsTime() {
+	if t.IsEnum || t.IsTime() || t.Import != "" {
 		return "Object"
 	}
 	if t.IsInstant() {
@@ -351,6 +375,9 @@ func (t ktType) IsInstant() bool {
 }
 
 func makeType(r *compiler.Result, col *compiler.Column, settings config.CombinedSettings) ktType {
+	if settings.Package.Engine == config.EnginePostgreSQL {
+		col = domainBase(r, col)
+	}
 	typ, isEnum := ktInnerType(r, col, settings)
 	return ktType{
 		Name:     typ,
@@ -362,6 +389,23 @@ func makeType(r *compiler.Result, col *compiler.Column, settings config.Combined
 	}
 }
 
+// makeAnnotatedType returns the type of a parameter or column that an @param or
+// @column annotation gives a Kotlin type to. A class with a package is
+// imported and bound as an object.
+func makeAnnotatedType(col *compiler.Column, spec string, settings config.CombinedSettings) ktType {
+	t := ktType{
+		Name:     spec,
+		IsNull:   !col.NotNull,
+		DataType: col.DataType,
+		Engine:   settings.Package.Engine,
+	}
+	if i := strings.LastIndex(spec, "."); i != -1 {
+		t.Name = spec[i+1:]
+		t.Import = spec
+	}
+	return t
+}
+
 func ktInnerType(r *compiler.Result, col *compiler.Column, settings config.CombinedSettings) (string, bool) {
 	// TODO: Extend the engine interface to handle types
 	switch settings.Package.Engine {
@@ -377,6 +421,42 @@ func ktInnerType(r *compiler.Result, col *compiler.Column, settings config.Combi
 type goColumn struct {
 	id int
 	*compiler.Column
+	embed *ktEmbed
+	// typ is the type given by an annotation of the query, if any
+	typ string
+}
+
+type ktEmbed struct {
+	modelType string
+	modelName string
+	fields    []Field
+}
+
+// look through all the structs and attempt to find a matching one to embed
+func newKtEmbed(embed *ast.TableName, structs []Struct) *ktEmbed {
+	if embed == nil {
+		return nil
+	}
+	for _, s := range structs {
+		if !sameTableName(embed, s.Table) {
+			continue
+		}
+		return &ktEmbed{
+			modelType: s.Name,
+			modelName: codegen.LowerTitle(s.Name),
+			fields:    s.Fields,
+		}
+	}
+	return nil
+}
+
+func hasEmbeds(columns []*compiler.Column) bool {
+	for _, c := range columns {
+		if c.EmbedTable != nil {
+			return true
+		}
+	}
+	return false
 }
 
 func ktColumnsToStruct(r *compiler.Result, name string, columns []goColumn, settings config.CombinedSettings, namer func(*compiler.Column, int) string) *Struct {
@@ -391,12 +471,28 @@ func ktColumnsToStruct(r *compiler.Result, name string, columns []goColumn, sett
 			continue
 		}
 		fieldName := MemberName(namer(c.Column, c.id), settings)
+		if c.embed != nil {
+			fieldName = c.embed.modelName
+		}
 		if v := nameSeen[c.Name]; v > 0 {
 			fieldName = fmt.Sprintf("%s_%d", fieldName, v+1)
 		}
-		field := Field{
-			Name: fieldName,
-			Type: makeType(r, c.Column, settings),
+		var field Field
+		if c.embed != nil {
+			field = Field{
+				Name:        fieldName,
+				Type:        ktType{Name: c.embed.modelType, Engine: settings.Package.Engine},
+				EmbedFields: c.embed.fields,
+			}
+		} else {
+			typ := makeType(r, c.Column, settings)
+			if c.typ != "" {
+				typ = makeAnnotatedType(c.Column, c.typ, settings)
+			}
+			field = Field{
+				Name: fieldName,
+				Type: typ,
+			}
 		}
 		gs.Fields = append(gs.Fields, field)
 		gs.JDBCParamBindings = append(gs.JDBCParamBindings, field)
@@ -470,6 +566,7 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 			cols = append(cols, goColumn{
 				id:     p.Number,
 				Column: p.Column,
+				typ:    query.ParamTypes[p.Number],
 			})
 		}
 		params := ktColumnsToStruct(r, gq.ClassName+"Bindings", cols, settings, ktParamName)
@@ -477,17 +574,26 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 			Struct: params,
 		}
 
-		if len(query.Columns) == 1 {
-			c := query.Columns[0]
+		columnType := func(i int) ktType {
+			if spec, ok := query.ColumnTypes[i]; ok {
+				return makeAnnotatedType(query.Columns[i], spec, settings)
+			}
+			return makeType(r, query.Columns[i], settings)
+		}
+
+		if len(query.Columns) == 1 && query.Columns[0].EmbedTable == nil {
 			gq.Ret = QueryValue{
 				Name: "results",
-				Typ:  makeType(r, c, settings),
+				Typ:  columnType(0),
 			}
-		} else if len(query.Columns) > 1 {
+		} else if len(query.Columns) >= 1 {
 			var gs *Struct
 			var emit bool
 
 			for _, s := range structs {
+				if hasEmbeds(query.Columns) {
+					break
+				}
 				if len(s.Fields) != len(query.Columns) {
 					continue
 				}
@@ -495,8 +601,8 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 				for i, f := range s.Fields {
 					c := query.Columns[i]
 					sameName := f.Name == MemberName(ktColumnName(c, i), settings)
-					sameType := f.Type == makeType(r, c, settings)
-					sameTable := sameTableName(c.Table, s.Table)
+					sameType := f.Type == columnType(i)
+					sameTable := sameTableName(modelTable(r, c.Table, settings), s.Table)
 
 					if !sameName || !sameType || !sameTable {
 						same = false
@@ -514,6 +620,8 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 					columns = append(columns, goColumn{
 						id:     i,
 						Column: c,
+						embed:  newKtEmbed(modelTable(r, c.EmbedTable, settings), structs),
+						typ:    query.ColumnTypes[i],
 					})
 				}
 				gs = ktColumnsToStruct(r, gq.ClassName+"Row", columns, settings, ktColumnName)
@@ -815,3 +923,12 @@ func Generate(r *compiler.Result, settings config.CombinedSettings) (map[string]
 
 	return output, nil
 }
+
+// modelTable returns the table whose model a column belongs to. Without
+// models of their own, partitions use the model of their partitioned table.
+func modelTable(r *compiler.Result, table *ast.TableName, settings config.CombinedSettings) *ast.TableName {
+	if settings.Kotlin.OmitPartitionModels {
+		return r.Catalog.PartitionRoot(table)
+	}
+	return table
+}
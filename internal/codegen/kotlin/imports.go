@@ -56,6 +56,9 @@ func (i *importer) interfaceImports() [][]string {
 	}
 
 	std := stdImports(uses)
+	for _, imp := range i.annotationImports() {
+		std[imp] = struct{}{}
+	}
 	stds := make([]string, 0, len(std))
 	for s := range std {
 		stds = append(stds, s)
@@ -93,6 +96,33 @@ func (i *importer) modelImports() [][]string {
 	return [][]string{stds}
 }
 
+// annotationImports returns the classes that the annotations of the queries
+// give as types
+func (i *importer) annotationImports() []string {
+	var imports []string
+	add := func(t ktType) {
+		if t.Import != "" {
+			imports = append(imports, t.Import)
+		}
+	}
+	for _, q := range i.Queries {
+		if !q.Ret.isEmpty() {
+			add(q.Ret.Typ)
+			if q.Ret.Struct != nil {
+				for _, f := range q.Ret.Struct.Fields {
+					add(f.Type)
+				}
+			}
+		}
+		if !q.Arg.isEmpty() {
+			for _, f := range q.Arg.Struct.Fields {
+				add(f.Type)
+			}
+		}
+	}
+	return imports
+}
+
 func stdImports(uses func(name string) bool) map[string]struct{} {
 	std := map[string]struct{}{
 		"java.sql.SQLException": {},
@@ -126,6 +156,11 @@ func (i *importer) queryImports(filename string) [][]string {
 						if f.Type.Name == name {
 							return true
 						}
+						for _, embed := range f.EmbedFields {
+							if embed.Type.Name == name {
+								return true
+							}
+						}
 					}
 				}
 				if q.Ret.Type() == name {
@@ -158,6 +193,9 @@ func (i *importer) queryImports(filename string) [][]string {
 
 	std := stdImports(uses)
 	std["java.sql.Connection"] = struct{}{}
+	for _, imp := range i.annotationImports() {
+		std[imp] = struct{}{}
+	}
 	if hasEnum() && i.Settings.Package.Engine == config.EnginePostgreSQL {
 		std["java.sql.Types"] = struct{}{}
 	}
@@ -29,7 +29,7 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 	case "double", "double precision", "real":
 		return "Double", false
 
-	case "decimal", "dec", "fixed":
+	case "decimal", "dec", "fixed", "numeric":
 		return "String", false
 
 	case "enum":
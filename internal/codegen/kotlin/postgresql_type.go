@@ -8,6 +8,33 @@ import (
 	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 )
 
+// domainBase returns the column with its domain, if it has one, replaced by
+// the base type of the domain. A domain over an array makes the column an
+// array of the element type.
+func domainBase(r *compiler.Result, col *compiler.Column) *compiler.Column {
+	for _, schema := range r.Catalog.Schemas {
+		if schema.Name == "pg_catalog" {
+			continue
+		}
+		for _, typ := range schema.Types {
+			t, ok := typ.(*catalog.Domain)
+			if !ok || col.DataType != t.Name {
+				continue
+			}
+			base := *col
+			base.DataType = t.BaseType.Name
+			if t.BaseType.Schema != "" {
+				base.DataType = t.BaseType.Schema + "." + t.BaseType.Name
+			}
+			base.NotNull = col.NotNull || t.IsNotNull
+			base.IsArray = col.IsArray || t.IsArray
+			// Domains may be defined over other domains
+			return domainBase(r, &base)
+		}
+	}
+	return col
+}
+
 func postgresType(r *compiler.Result, col *compiler.Column, settings config.CombinedSettings) (string, bool) {
 	columnType := col.DataType
 
@@ -53,17 +80,17 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		// Date and time mappings from https://jdbc.postgresql.org/documentation/head/java8-date-time.html
 		return "LocalDate", false
 
-	case "pg_catalog.time", "pg_catalog.timetz":
+	case "pg_catalog.time", "pg_catalog.timetz", "time without time zone", "time with time zone":
 		return "LocalTime", false
 
-	case "pg_catalog.timestamp":
+	case "pg_catalog.timestamp", "timestamp without time zone":
 		return "LocalDateTime", false
 
-	case "pg_catalog.timestamptz", "timestamptz":
+	case "pg_catalog.timestamptz", "timestamptz", "timestamp with time zone":
 		// TODO
 		return "OffsetDateTime", false
 
-	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string":
+	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string", "character varying", "character":
 		return "String", false
 
 	case "uuid":
@@ -90,15 +117,14 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 				continue
 			}
 			for _, typ := range schema.Types {
-				enum, ok := typ.(*catalog.Enum)
-				if !ok {
-					continue
-				}
-				if columnType == enum.Name {
-					if schema.Name == r.Catalog.DefaultSchema {
-						return DataClassName(enum.Name, settings), true
+				switch t := typ.(type) {
+				case *catalog.Enum:
+					if columnType == t.Name {
+						if schema.Name == r.Catalog.DefaultSchema {
+							return DataClassName(t.Name, settings), true
+						}
+						return DataClassName(schema.Name+"_"+t.Name, settings), true
 					}
-					return DataClassName(schema.Name+"_"+enum.Name, settings), true
 				}
 			}
 		}
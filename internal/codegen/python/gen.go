@@ -51,6 +51,8 @@ type Field struct {
 	Name    string
 	Type    pyType
 	Comment string
+	// EmbedFields are the fields of the model class for sqlc.embed()
+	EmbedFields []Field
 }
 
 type Struct struct {
@@ -106,8 +108,19 @@ func (v QueryValue) StructRowParser(rowVar string, indentCount int) string {
 	}
 	indent := strings.Repeat(" ", indentCount+4)
 	params := make([]string, 0, len(v.Struct.Fields))
-	for i, f := range v.Struct.Fields {
-		params = append(params, fmt.Sprintf("%s%s=%s[%v],", indent, f.Name, rowVar, i))
+	idx := 0
+	for _, f := range v.Struct.Fields {
+		if len(f.EmbedFields) > 0 {
+			inner := make([]string, 0, len(f.EmbedFields))
+			for _, embed := range f.EmbedFields {
+				inner = append(inner, fmt.Sprintf("%s=%s[%v]", embed.Name, rowVar, idx))
+				idx++
+			}
+			params = append(params, fmt.Sprintf("%s%s=%s(%s),", indent, f.Name, f.Type.InnerType, strings.Join(inner, ", ")))
+			continue
+		}
+		params = append(params, fmt.Sprintf("%s%s=%s[%v],", indent, f.Name, rowVar, idx))
+		idx++
 	}
 	indent = strings.Repeat(" ", indentCount)
 	return v.Type() + "(\n" + strings.Join(params, "\n") + "\n" + indent + ")"
@@ -176,6 +189,42 @@ func makePyType(r *compiler.Result, col *compiler.Column, settings config.Combin
 	}
 }
 
+// makeAnnotatedPyType returns the type of a parameter or column that an @param
+// or @column annotation gives a Python type to. Like an override, the
+// annotation replaces the inner type.
+func makeAnnotatedPyType(col *compiler.Column, spec string) pyType {
+	return pyType{
+		InnerType: spec,
+		IsArray:   col.IsArray,
+		IsNull:    !col.NotNull,
+	}
+}
+
+// withAnnotationTypes adds the types of the @param and @column annotations of
+// the queries to the overrides of the settings. They don't match any column or
+// database type, but let the importer find the modules of the types.
+func withAnnotationTypes(r *compiler.Result, settings config.CombinedSettings) config.CombinedSettings {
+	overrides := settings.Overrides[:len(settings.Overrides):len(settings.Overrides)]
+	for _, query := range r.Queries {
+		var specs []string
+		for _, spec := range query.ParamTypes {
+			specs = append(specs, spec)
+		}
+		for _, spec := range query.ColumnTypes {
+			specs = append(specs, spec)
+		}
+		for _, spec := range specs {
+			typ := config.PythonType{Name: spec}
+			if i := strings.LastIndex(spec, "."); i != -1 {
+				typ = config.PythonType{Module: spec[:i], Name: spec[i+1:]}
+			}
+			overrides = append(overrides, config.Override{PythonType: typ})
+		}
+	}
+	settings.Overrides = overrides
+	return settings
+}
+
 func pyInnerType(r *compiler.Result, col *compiler.Column, settings config.CombinedSettings) string {
 	for _, oride := range settings.Overrides {
 		if !oride.PythonType.IsSet() {
@@ -273,6 +322,9 @@ func buildModels(r *compiler.Result, settings config.CombinedSettings) []Struct
 			continue
 		}
 		for _, table := range schema.Tables {
+			if settings.Python.OmitPartitionModels && table.IsPartition() {
+				continue
+			}
 			var tableName string
 			if schema.Name == r.Catalog.DefaultSchema {
 				tableName = table.Rel.Name
@@ -294,7 +346,7 @@ func buildModels(r *compiler.Result, settings config.CombinedSettings) []Struct
 				s.Fields = append(s.Fields, Field{
 					Name:    column.Name,
 					Type:    typ,
-					Comment: column.Comment,
+					Comment: codegen.ColumnComment(column),
 				})
 			}
 			structs = append(structs, s)
@@ -323,6 +375,42 @@ func paramName(p compiler.Parameter) string {
 type pyColumn struct {
 	id int
 	*compiler.Column
+	embed *pyEmbed
+	// typ is the type given by an annotation of the query, if any
+	typ string
+}
+
+type pyEmbed struct {
+	modelType string
+	modelName string
+	fields    []Field
+}
+
+// look through all the structs and attempt to find a matching one to embed
+func newPyEmbed(embed *ast.TableName, structs []Struct, defaultSchema string) *pyEmbed {
+	if embed == nil {
+		return nil
+	}
+	for _, s := range structs {
+		if !sameTableName(embed, s.Table, defaultSchema) {
+			continue
+		}
+		return &pyEmbed{
+			modelType: "models." + s.Name,
+			modelName: MethodName(s.Name),
+			fields:    s.Fields,
+		}
+	}
+	return nil
+}
+
+func hasEmbeds(columns []*compiler.Column) bool {
+	for _, c := range columns {
+		if c.EmbedTable != nil {
+			return true
+		}
+	}
+	return false
 }
 
 func columnsToStruct(r *compiler.Result, name string, columns []pyColumn, settings config.CombinedSettings) *Struct {
@@ -334,6 +422,9 @@ func columnsToStruct(r *compiler.Result, name string, columns []pyColumn, settin
 	for i, c := range columns {
 		colName := columnName(c.Column, i)
 		fieldName := colName
+		if c.embed != nil {
+			fieldName = c.embed.modelName
+		}
 		// Track suffixes by the ID of the column, so that columns referring to the same numbered parameter can be
 		// reused.
 		suffix := 0
@@ -346,10 +437,22 @@ func columnsToStruct(r *compiler.Result, name string, columns []pyColumn, settin
 		if suffix > 0 {
 			fieldName = fmt.Sprintf("%s_%d", fieldName, suffix)
 		}
-		gs.Fields = append(gs.Fields, Field{
-			Name: fieldName,
-			Type: makePyType(r, c.Column, settings),
-		})
+		if c.embed != nil {
+			gs.Fields = append(gs.Fields, Field{
+				Name:        fieldName,
+				Type:        pyType{InnerType: c.embed.modelType},
+				EmbedFields: c.embed.fields,
+			})
+		} else {
+			typ := makePyType(r, c.Column, settings)
+			if c.typ != "" {
+				typ = makeAnnotatedPyType(c.Column, c.typ)
+			}
+			gs.Fields = append(gs.Fields, Field{
+				Name: fieldName,
+				Type: typ,
+			})
+		}
 		seen[colName]++
 	}
 	return &gs
@@ -400,12 +503,26 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 			SourceName:   query.Filename,
 		}
 
+		paramType := func(p compiler.Parameter) pyType {
+			if spec, ok := query.ParamTypes[p.Number]; ok {
+				return makeAnnotatedPyType(p.Column, spec)
+			}
+			return makePyType(r, p.Column, settings)
+		}
+		columnType := func(i int) pyType {
+			if spec, ok := query.ColumnTypes[i]; ok {
+				return makeAnnotatedPyType(query.Columns[i], spec)
+			}
+			return makePyType(r, query.Columns[i], settings)
+		}
+
 		if len(query.Params) > 4 {
 			var cols []pyColumn
 			for _, p := range query.Params {
 				cols = append(cols, pyColumn{
 					id:     p.Number,
 					Column: p.Column,
+					typ:    query.ParamTypes[p.Number],
 				})
 			}
 			gq.Args = []QueryValue{{
@@ -418,23 +535,26 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 			for _, p := range query.Params {
 				args = append(args, QueryValue{
 					Name: paramName(p),
-					Typ:  makePyType(r, p.Column, settings),
+					Typ:  paramType(p),
 				})
 			}
 			gq.Args = args
 		}
 
-		if len(query.Columns) == 1 {
+		if len(query.Columns) == 1 && query.Columns[0].EmbedTable == nil {
 			c := query.Columns[0]
 			gq.Ret = QueryValue{
 				Name: columnName(c, 0),
-				Typ:  makePyType(r, c, settings),
+				Typ:  columnType(0),
 			}
-		} else if len(query.Columns) > 1 {
+		} else if len(query.Columns) >= 1 {
 			var gs *Struct
 			var emit bool
 
 			for _, s := range structs {
+				if hasEmbeds(query.Columns) {
+					break
+				}
 				if len(s.Fields) != len(query.Columns) {
 					continue
 				}
@@ -442,11 +562,11 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 				for i, f := range s.Fields {
 					c := query.Columns[i]
 					// HACK: models do not have "models." on their types, so trim that so we can find matches
-					trimmedPyType := makePyType(r, c, settings)
+					trimmedPyType := columnType(i)
 					trimmedPyType.InnerType = strings.TrimPrefix(trimmedPyType.InnerType, "models.")
 					sameName := f.Name == columnName(c, i)
 					sameType := f.Type == trimmedPyType
-					sameTable := sameTableName(c.Table, s.Table, r.Catalog.DefaultSchema)
+					sameTable := sameTableName(modelTable(r, c.Table, settings), s.Table, r.Catalog.DefaultSchema)
 					if !sameName || !sameType || !sameTable {
 						same = false
 					}
@@ -463,6 +583,8 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 					columns = append(columns, pyColumn{
 						id:     i,
 						Column: c,
+						embed:  newPyEmbed(modelTable(r, c.EmbedTable, settings), structs, r.Catalog.DefaultSchema),
+						typ:    query.ColumnTypes[i],
 					})
 				}
 				gs = columnsToStruct(r, query.Name+"Row", columns, settings)
@@ -656,6 +778,7 @@ func HashComment(s string) string {
 }
 
 func Generate(r *compiler.Result, settings config.CombinedSettings) (map[string]string, error) {
+	settings = withAnnotationTypes(r, settings)
 	enums := buildEnums(r, settings)
 	models := buildModels(r, settings)
 	queries := buildQueries(r, settings, models)
@@ -720,3 +843,12 @@ func Generate(r *compiler.Result, settings config.CombinedSettings) (map[string]
 
 	return output, nil
 }
+
+// modelTable returns the table whose model a column belongs to. Without
+// models of their own, partitions use the model of their partitioned table.
+func modelTable(r *compiler.Result, table *ast.TableName, settings config.CombinedSettings) *ast.TableName {
+	if settings.Python.OmitPartitionModels {
+		return r.Catalog.PartitionRoot(table)
+	}
+	return table
+}
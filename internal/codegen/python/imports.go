@@ -96,6 +96,10 @@ func (i *importer) modelImports() []string {
 
 	for _, o := range i.Settings.Overrides {
 		if o.PythonType.IsSet() && o.PythonType.Module != "" {
+			// Standard library modules are already imported
+			if _, ok := std[o.PythonType.Module]; ok {
+				continue
+			}
 			if modelUses(o.PythonType.TypeString()) {
 				pkg[o.PythonType.Module] = importSpec{Module: o.PythonType.Module}
 			}
@@ -138,6 +142,10 @@ func (i *importer) queryImports(fileName string) []string {
 
 	for _, o := range i.Settings.Overrides {
 		if o.PythonType.IsSet() && o.PythonType.Module != "" {
+			// Standard library modules are already imported
+			if _, ok := std[o.PythonType.Module]; ok {
+				continue
+			}
 			if queryUses(o.PythonType.TypeString()) {
 				pkg[o.PythonType.Module] = importSpec{Module: o.PythonType.Module}
 			}
@@ -25,13 +25,13 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		return "memoryview"
 	case "date":
 		return "datetime.date"
-	case "pg_catalog.time", "pg_catalog.timetz":
+	case "pg_catalog.time", "pg_catalog.timetz", "time without time zone", "time with time zone":
 		return "datetime.time"
-	case "pg_catalog.timestamp", "pg_catalog.timestamptz", "timestamptz":
+	case "pg_catalog.timestamp", "pg_catalog.timestamptz", "timestamptz", "timestamp without time zone", "timestamp with time zone":
 		return "datetime.datetime"
 	case "interval", "pg_catalog.interval":
 		return "datetime.timedelta"
-	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string", "citext":
+	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string", "citext", "character varying", "character":
 		return "str"
 	case "uuid":
 		return "uuid.UUID"
@@ -42,21 +42,35 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		return "str"
 	case "ltree", "lquery", "ltxtquery":
 		return "str"
+	case "void":
+		// A void value is always NULL
+		return "None"
 	default:
 		for _, schema := range r.Catalog.Schemas {
 			if schema.Name == "pg_catalog" {
 				continue
 			}
 			for _, typ := range schema.Types {
-				enum, ok := typ.(*catalog.Enum)
-				if !ok {
-					continue
-				}
-				if columnType == enum.Name {
-					if schema.Name == r.Catalog.DefaultSchema {
-						return "models." + ModelName(enum.Name, settings)
+				switch t := typ.(type) {
+				case *catalog.Enum:
+					if columnType == t.Name {
+						if schema.Name == r.Catalog.DefaultSchema {
+							return "models." + ModelName(t.Name, settings)
+						}
+						return "models." + ModelName(schema.Name+"_"+t.Name, settings)
+					}
+				case *catalog.Domain:
+					if columnType == t.Name {
+						base := *col
+						base.DataType = t.BaseType.Name
+						if t.BaseType.Schema != "" {
+							base.DataType = t.BaseType.Schema + "." + t.BaseType.Name
+						}
+						if t.IsArray {
+							return "List[" + pyInnerType(r, &base, settings) + "]"
+						}
+						return pyInnerType(r, &base, settings)
 					}
-					return "models." + ModelName(schema.Name+"_"+enum.Name, settings)
 				}
 			}
 		}
@@ -3,6 +3,8 @@ package codegen
 import (
 	"strings"
 	"unicode"
+
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 )
 
 func LowerTitle(s string) string {
@@ -30,3 +32,24 @@ func EscapeBacktick(s string) string {
 func DoubleSlashComment(s string) string {
 	return "// " + strings.ReplaceAll(s, "\n", "\n// ")
 }
+
+// ColumnComment returns the documentation for the model field of a column.
+// Besides the column's comment, it notes the values the database fills in.
+func ColumnComment(col *catalog.Column) string {
+	var lines []string
+	if col.Comment != "" {
+		lines = append(lines, col.Comment)
+	}
+	switch {
+	case col.Generated != 0:
+		lines = append(lines, "Generated column")
+	case col.Identity == 'a':
+		lines = append(lines, "Identity column, always generated")
+	case col.Identity != 0:
+		lines = append(lines, "Identity column")
+	}
+	if col.Default != "" {
+		lines = append(lines, "Default: "+col.Default)
+	}
+	return strings.Join(lines, "\n")
+}
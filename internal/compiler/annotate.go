@@ -0,0 +1,67 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/metadata"
+	"github.com/kyleconroy/sqlc/internal/sql/named"
+)
+
+// annotate applies the @param and @column annotations of a query. Parameters
+// are renamed here, while the types are left to the code generators and are
+// returned keyed by parameter number and column position.
+func annotate(name string, annotations []metadata.Annotation, params []Parameter, cols []*Column, names map[int]named.Param) (map[int]string, map[int]string, error) {
+	paramTypes := map[int]string{}
+	colTypes := map[int]string{}
+	var n, count int
+	for _, a := range annotations {
+		if a.Kind == metadata.AnnotationParam {
+			count++
+		}
+	}
+	if count > len(params) {
+		return nil, nil, fmt.Errorf("query %q annotates %d parameters with %s, but only has %d", name, count, metadata.AnnotationParam, len(params))
+	}
+	seen := map[string]bool{}
+	for _, a := range annotations {
+		switch a.Kind {
+
+		case metadata.AnnotationParam:
+			// The annotations describe the parameters in order, like the
+			// arguments of a function
+			p := &params[n]
+			n++
+			for num, np := range names {
+				if np.Name == a.Name && num != p.Number && names[p.Number].Name != a.Name {
+					return nil, nil, fmt.Errorf("query %q annotates $%d as %q, which is the name of $%d", name, p.Number, a.Name, num)
+				}
+			}
+			col := Column{}
+			if p.Column != nil {
+				col = *p.Column
+			}
+			col.Name = a.Name
+			p.Column = &col
+			if a.Type != "" {
+				paramTypes[p.Number] = a.Type
+			}
+
+		case metadata.AnnotationColumn:
+			if seen[a.Name] {
+				return nil, nil, fmt.Errorf("query %q annotates column %q more than once", name, a.Name)
+			}
+			seen[a.Name] = true
+			var found bool
+			for i, c := range cols {
+				if c.Name == a.Name && c.EmbedTable == nil {
+					colTypes[i] = a.Type
+					found = true
+				}
+			}
+			if !found {
+				return nil, nil, fmt.Errorf("query %q has no column %q to annotate", name, a.Name)
+			}
+		}
+	}
+	return paramTypes, colTypes, nil
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/kyleconroy/sqlc/internal/multierr"
 	"github.com/kyleconroy/sqlc/internal/opts"
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
-	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlpath"
 )
@@ -54,11 +53,14 @@ func enumValueName(value string) string {
 }
 
 // end copypasta
-func parseCatalog(p Parser, c *catalog.Catalog, schemas []string) error {
+func (c *Compiler) parseCatalog(schemas []string) error {
 	files, err := sqlpath.Glob(schemas)
 	if err != nil {
 		return err
 	}
+	if c.conf.SearchPath != nil {
+		c.catalog.SearchPath = c.conf.SearchPath
+	}
 	merr := multierr.New()
 	for _, filename := range files {
 		blob, err := ioutil.ReadFile(filename)
@@ -67,13 +69,13 @@ func parseCatalog(p Parser, c *catalog.Catalog, schemas []string) error {
 			continue
 		}
 		contents := migrations.RemoveRollbackStatements(string(blob))
-		stmts, err := p.Parse(strings.NewReader(contents))
+		stmts, err := c.parser.Parse(strings.NewReader(contents))
 		if err != nil {
 			merr.Add(filename, contents, 0, err)
 			continue
 		}
 		for i := range stmts {
-			if err := c.Update(stmts[i]); err != nil {
+			if err := c.catalog.Update(stmts[i], c); err != nil {
 				merr.Add(filename, contents, stmts[i].Pos(), err)
 				continue
 			}
@@ -82,24 +84,43 @@ func parseCatalog(p Parser, c *catalog.Catalog, schemas []string) error {
 	if len(merr.Errs()) > 0 {
 		return merr
 	}
+	// Queries run with the configured search path, whatever the schema files
+	// set it to
+	if c.conf.SearchPath != nil {
+		c.catalog.SearchPath = c.conf.SearchPath
+	}
 	return nil
 }
 
+type queryFile struct {
+	name string
+	src  string
+}
+
 func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 	var q []*Query
 	merr := multierr.New()
-	set := map[string]struct{}{}
+	set := map[string]string{}
 	files, err := sqlpath.Glob(c.conf.Queries)
 	if err != nil {
 		return nil, err
 	}
+	var sources []queryFile
+	// The functions come first, so that a query that reuses the name of a
+	// function is reported in the file that holds it
+	if c.emitFunctions() {
+		sources = append(sources, queryFile{functionsFilename, c.functionSource()})
+	}
 	for _, filename := range files {
 		blob, err := ioutil.ReadFile(filename)
 		if err != nil {
 			merr.Add(filename, "", 0, err)
 			continue
 		}
-		src := string(blob)
+		sources = append(sources, queryFile{filename, string(blob)})
+	}
+	for _, file := range sources {
+		filename, src := file.name, file.src
 		stmts, err := c.parser.Parse(strings.NewReader(src))
 		if err != nil {
 			merr.Add(filename, src, 0, err)
@@ -120,11 +141,15 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 				continue
 			}
 			if query.Name != "" {
-				if _, exists := set[query.Name]; exists {
-					merr.Add(filename, src, stmt.Raw.Pos(), fmt.Errorf("duplicate query name: %s", query.Name))
+				if prev, exists := set[query.Name]; exists {
+					err := fmt.Errorf("duplicate query name: %s", query.Name)
+					if prev == functionsFilename {
+						err = fmt.Errorf("duplicate query name: %s is also the name of a function emitted by emit_functions", query.Name)
+					}
+					merr.Add(filename, src, stmt.Raw.Pos(), err)
 					continue
 				}
-				set[query.Name] = struct{}{}
+				set[query.Name] = filename
 			}
 			query.Filename = filepath.Base(filename)
 			if query != nil {
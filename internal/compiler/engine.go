@@ -37,12 +37,18 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings) *Compiler {
 	return c
 }
 
+// Batch commands are built on pgx's SendBatch, so they're only available to
+// PostgreSQL packages that generate pgx code.
+func (c *Compiler) usesPgx() bool {
+	return c.conf.Engine == config.EnginePostgreSQL && c.combo.Go.SQLPackage == config.SQLPackagePGX
+}
+
 func (c *Compiler) Catalog() *catalog.Catalog {
 	return c.catalog
 }
 
 func (c *Compiler) ParseCatalog(schema []string) error {
-	return parseCatalog(c.parser, c.catalog, schema)
+	return c.parseCatalog(schema)
 }
 
 func (c *Compiler) ParseQueries(queries []string, o opts.Parser) error {
@@ -7,7 +7,7 @@ import (
 
 func findParameters(root ast.Node) []paramRef {
 	refs := make([]paramRef, 0)
-	v := paramSearch{seen: make(map[int]struct{}), refs: &refs}
+	v := paramSearch{seen: make(map[int]struct{}), refs: &refs, setOps: make(map[int]*setOpTarget)}
 	astutils.Walk(v, root)
 	return refs
 }
@@ -17,13 +17,60 @@ type paramRef struct {
 	rv     *ast.RangeVar
 	ref    *ast.ParamRef
 	name   string // Named parameter support
+	scope  *paramScope
+	path   []ast.Node // The nodes that the parameter is nested in
+}
+
+// A paramScope holds the relations that columns in a statement may refer to.
+// Nested statements, such as subqueries and common table expressions, have
+// their own scope, which falls back to the enclosing one.
+type paramScope struct {
+	rvs    []*ast.RangeVar
+	parent *paramScope
+
+	// The table of an INSERT ... ON CONFLICT, whose proposed row is known as
+	// excluded
+	excluded *ast.RangeVar
+}
+
+func newParamScope(parent *paramScope, rv *ast.RangeVar, from *ast.List) *paramScope {
+	scope := &paramScope{parent: parent}
+	if rv != nil {
+		scope.rvs = append(scope.rvs, rv)
+	}
+	if from != nil {
+		astutils.Walk(scopeSearch{rvs: &scope.rvs}, from)
+	}
+	return scope
+}
+
+// scopeSearch finds the relations in a FROM clause, skipping any that belong
+// to nested statements
+type scopeSearch struct {
+	rvs *[]*ast.RangeVar
+}
+
+func (s scopeSearch) Visit(node ast.Node) astutils.Visitor {
+	switch n := node.(type) {
+	case *ast.RangeVar:
+		*s.rvs = append(*s.rvs, n)
+		return nil
+	case *ast.RangeSubselect, *ast.RangeFunction, *ast.SubLink:
+		return nil
+	}
+	return s
 }
 
 type paramSearch struct {
 	parent   ast.Node
+	path     []ast.Node
 	rangeVar *ast.RangeVar
 	refs     *[]paramRef
 	seen     map[int]struct{}
+	scope    *paramScope
+
+	// Parameters selected by the arms of a set operation, by location
+	setOps map[int]*setOpTarget
 
 	// XXX: Gross state hack for limit
 	limitCount  ast.Node
@@ -44,7 +91,50 @@ func (l *limitOffset) Pos() int {
 	return 0
 }
 
+// A parameter selected by an arm of a set operation takes the type of the
+// column that it ends up in
+type setOpTarget struct {
+	stmt  *ast.SelectStmt
+	index int
+}
+
+func (s *setOpTarget) Pos() int {
+	return 0
+}
+
+func (p paramSearch) findSetOpTargets(root, arm *ast.SelectStmt) {
+	if arm.Op != ast.None {
+		if arm.Larg != nil {
+			p.findSetOpTargets(root, arm.Larg)
+		}
+		if arm.Rarg != nil {
+			p.findSetOpTargets(root, arm.Rarg)
+		}
+		return
+	}
+	if arm.TargetList == nil {
+		return
+	}
+	for i, item := range arm.TargetList.Items {
+		res, ok := item.(*ast.ResTarget)
+		if !ok {
+			continue
+		}
+		ref, ok := res.Val.(*ast.ParamRef)
+		if !ok {
+			continue
+		}
+		// Nested set operations are typed as part of the outermost one
+		if _, found := p.setOps[ref.Location]; !found {
+			p.setOps[ref.Location] = &setOpTarget{stmt: root, index: i}
+		}
+	}
+}
+
 func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
+	path := p.path
+	p.path = append(path[:len(path):len(path)], node)
+
 	switch n := node.(type) {
 
 	case *ast.A_Expr:
@@ -53,7 +143,14 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 	case *ast.FuncCall:
 		p.parent = node
 
+	case *ast.DeleteStmt:
+		p.scope = newParamScope(p.scope, n.Relation, n.UsingClause)
+
 	case *ast.InsertStmt:
+		p.scope = newParamScope(p.scope, n.Relation, nil)
+		if n.OnConflictClause != nil {
+			p.scope.excluded = n.Relation
+		}
 		if s, ok := n.SelectStmt.(*ast.SelectStmt); ok {
 			for i, item := range s.TargetList.Items {
 				target, ok := item.(*ast.ResTarget)
@@ -92,6 +189,10 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 		p.parent = node
 
 	case *ast.SelectStmt:
+		p.scope = newParamScope(p.scope, nil, n.FromClause)
+		if n.Op != ast.None {
+			p.findSetOpTargets(n, n)
+		}
 		if n.LimitCount != nil {
 			p.limitCount = n.LimitCount
 		}
@@ -102,8 +203,15 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 	case *ast.TypeCast:
 		p.parent = node
 
+	case *ast.UpdateStmt:
+		p.scope = newParamScope(p.scope, n.Relation, n.FromClause)
+		p.rangeVar = n.Relation
+
 	case *ast.ParamRef:
 		parent := p.parent
+		if target, ok := p.setOps[n.Location]; ok {
+			parent = target
+		}
 
 		if count, ok := p.limitCount.(*ast.ParamRef); ok {
 			if n.Number == count.Number {
@@ -138,7 +246,7 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 		}
 
 		if set {
-			*p.refs = append(*p.refs, paramRef{parent: parent, ref: n, rv: p.rangeVar})
+			*p.refs = append(*p.refs, paramRef{parent: parent, ref: n, rv: p.rangeVar, scope: p.scope, path: path})
 			p.seen[n.Location] = struct{}{}
 		}
 		return nil
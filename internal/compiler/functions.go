@@ -0,0 +1,202 @@
+package compiler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/config"
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// The queries for emit_functions are compiled as if they came from this file
+const functionsFilename = "functions.sql"
+
+func (c *Compiler) emitFunctions() bool {
+	if c.conf.Engine != config.EnginePostgreSQL {
+		return false
+	}
+	return c.combo.Go.EmitFunctions || c.combo.Kotlin.EmitFunctions || c.combo.Python.EmitFunctions
+}
+
+func isSystemSchema(name string) bool {
+	switch name {
+	case "pg_catalog", "pg_temp", "information_schema":
+		return true
+	}
+	return false
+}
+
+// functionSource writes a query for each user-defined function and procedure.
+// The queries are compiled like any other, so that parameters and result
+// columns are typed the same way.
+func (c *Compiler) functionSource() string {
+	var b strings.Builder
+	for _, s := range c.catalog.Schemas {
+		if isSystemSchema(s.Name) {
+			continue
+		}
+		overloads := map[string]int{}
+		for _, fn := range s.Funcs {
+			if fn.Extension == "" {
+				overloads[fn.Name]++
+			}
+		}
+		for _, fn := range s.Funcs {
+			if fn.Extension != "" {
+				continue
+			}
+			cmd, query := c.functionQuery(s, fn, overloads[fn.Name] > 1)
+			if query == "" {
+				continue
+			}
+			name := fn.Name
+			if s.Name != c.catalog.DefaultSchema {
+				name = s.Name + "_" + name
+			}
+			// Overloaded functions are told apart by their argument types
+			if overloads[fn.Name] > 1 {
+				name += argTypesSuffix(fn)
+			}
+			fmt.Fprintf(&b, "-- name: %s %s\n%s;\n\n", structName(name), cmd, query)
+		}
+	}
+	return b.String()
+}
+
+// argTypesSuffix joins the types of the input arguments of a function, such
+// as "_int4_text" for add(int4, text)
+func argTypesSuffix(fn *catalog.Function) string {
+	var suffix string
+	for _, arg := range fn.InArgs() {
+		if arg.Type == nil {
+			continue
+		}
+		suffix += "_" + arg.Type.Name
+		if arg.Type.ArrayBounds != nil && len(arg.Type.ArrayBounds.Items) > 0 {
+			suffix += "_array"
+		}
+	}
+	return suffix
+}
+
+// functionQuery returns the statement, and its command, that calls a function.
+// Functions that can't be called directly, such as triggers, result in an
+// empty statement. The arguments of an overloaded function are cast to their
+// types, so that the call resolves to the right one.
+func (c *Compiler) functionQuery(s *catalog.Schema, fn *catalog.Function, overloaded bool) (string, string) {
+	argNames := functionArgNames(fn)
+	name := c.quoteName(fn.Name)
+	if s.Name != c.catalog.DefaultSchema {
+		name = c.quoteName(s.Name) + "." + name
+	}
+	var args []string
+	for i, arg := range fn.InArgs() {
+		param := fmt.Sprintf("sqlc.arg(%s)", c.quoteName(argNames[i]))
+		if overloaded && arg.Type != nil {
+			param += "::" + c.typeCast(arg.Type)
+		}
+		if arg.Mode == ast.FuncParamVariadic {
+			param = "VARIADIC " + param
+		}
+		args = append(args, param)
+	}
+	call := fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+
+	if fn.IsProcedure {
+		return ":exec", "CALL " + call
+	}
+	rt := fn.ReturnType
+	if rt == nil {
+		return "", ""
+	}
+	switch rt.Name {
+	case "trigger", "event_trigger":
+		return "", ""
+	case "void":
+		return ":exec", "SELECT " + call
+	}
+	cmd := ":one"
+	if rt.Setof {
+		cmd = ":many"
+	}
+	if c.returnsRow(fn) {
+		return cmd, "SELECT * FROM " + call
+	}
+	if rt.Name == "record" {
+		// A record without OUT parameters needs a column definition list
+		return "", ""
+	}
+	if rt.Setof {
+		return cmd, "SELECT * FROM " + call
+	}
+	return cmd, "SELECT " + call
+}
+
+// reservedArgNames are taken by the generated code, such as the receiver and
+// context of a Go method, or the instance of a Python method
+var reservedArgNames = map[string]bool{
+	"ctx":  true,
+	"q":    true,
+	"self": true,
+}
+
+// functionArgNames names the parameters of a function call after the input
+// arguments of the function. Unnamed arguments are named after their position,
+// and names that clash with the generated code get an "_arg" suffix.
+func functionArgNames(fn *catalog.Function) []string {
+	inArgs := fn.InArgs()
+	taken := map[string]bool{}
+	for _, arg := range inArgs {
+		taken[arg.Name] = true
+	}
+	names := make([]string, 0, len(inArgs))
+	for i, arg := range inArgs {
+		name := arg.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i+1)
+		}
+		for reservedArgNames[name] || (name != arg.Name && taken[name]) {
+			name += "_arg"
+		}
+		taken[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// returnsRow reports whether a function returns columns, rather than a single
+// value
+func (c *Compiler) returnsRow(fn *catalog.Function) bool {
+	if len(fn.OutArgs()) > 1 {
+		return true
+	}
+	rt := fn.ReturnType
+	if _, err := c.catalog.GetTable(&ast.TableName{Schema: rt.Schema, Name: rt.Name}); err == nil {
+		return true
+	}
+	_, err := c.catalog.GetCompositeType(rt)
+	return err == nil
+}
+
+// typeCast returns the name of a type as it's written in a cast
+func (c *Compiler) typeCast(t *ast.TypeName) string {
+	name := c.quoteName(t.Name)
+	if t.Schema != "" && t.Schema != "pg_catalog" {
+		name = c.quoteName(t.Schema) + "." + name
+	}
+	if t.ArrayBounds != nil && len(t.ArrayBounds.Items) > 0 {
+		name += "[]"
+	}
+	return name
+}
+
+var simpleIdent = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+func (c *Compiler) quoteName(name string) string {
+	if !simpleIdent.MatchString(name) {
+		return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	}
+	return c.quoteIdent(name)
+}
@@ -0,0 +1,324 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/lang"
+	"github.com/kyleconroy/sqlc/internal/sql/named"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// inferParameters types the parameters that couldn't be resolved to a column
+// or a function argument by looking at the expressions that they're part of.
+// A parameter that can't be typed at all is an error.
+func inferParameters(qc *QueryCatalog, refs []paramRef, params []Parameter, names map[int]named.Param) ([]Parameter, error) {
+	index := map[int]int{}
+	for i, p := range params {
+		if _, ok := index[p.Number]; !ok {
+			index[p.Number] = i
+		}
+	}
+	var added bool
+	for _, ref := range refs {
+		i, found := index[ref.ref.Number]
+		if found && params[i].Column != nil && params[i].Column.DataType != "any" {
+			continue
+		}
+		t := &paramTyper{qc: qc, ref: ref.ref}
+		col := t.contextType(ref.path, ref.ref)
+		if col.DataType == "any" {
+			// Arguments of functions that sqlc doesn't know about, or that
+			// take any type, stay untyped
+			if _, ok := ref.parent.(*ast.FuncCall); ok && found && params[i].Column != nil {
+				continue
+			}
+			return nil, &sqlerr.Error{
+				Code:     "42P18",
+				Message:  fmt.Sprintf("could not determine data type of parameter $%d", ref.ref.Number),
+				Location: ref.ref.Location,
+			}
+		}
+		if p, ok := names[ref.ref.Number]; ok {
+			col.Name = p.Name
+		}
+		if found {
+			params[i].Column = col
+			continue
+		}
+		index[ref.ref.Number] = len(params)
+		params = append(params, Parameter{Number: ref.ref.Number, Column: col})
+		added = true
+	}
+	if added {
+		sort.SliceStable(params, func(i, j int) bool { return params[i].Number < params[j].Number })
+	}
+	return params, nil
+}
+
+// paramTyper infers the type of a parameter from its context
+type paramTyper struct {
+	qc  *QueryCatalog
+	ref *ast.ParamRef
+}
+
+// contextType returns the type that the expression node is expected to have,
+// given the nodes that it's nested in. The type comes from the opposite
+// operand of an operator, the other values of a list or the clause that the
+// expression is in. Expressions that take the type of their operands, such as
+// arithmetic and CASE, pass the question on to their own context.
+func (t *paramTyper) contextType(path []ast.Node, node ast.Node) *Column {
+	if len(path) == 0 {
+		return unknownColumn()
+	}
+	parent, up := path[len(path)-1], path[:len(path)-1]
+
+	switch n := parent.(type) {
+
+	case *ast.List:
+		// Lists are handled by the node that they belong to
+		return t.contextType(up, n)
+
+	case *ast.A_Expr:
+		return t.aExprType(path, n, node)
+
+	case *ast.BoolExpr:
+		return boolColumn(true)
+
+	case *ast.CaseExpr:
+		if node == n.Arg {
+			var whens []ast.Node
+			for _, item := range n.Args.Items {
+				if when, ok := item.(*ast.CaseWhen); ok {
+					whens = append(whens, when.Expr)
+				}
+			}
+			return t.firstTyped(path, whens...)
+		}
+		return t.caseResultType(up, n)
+
+	case *ast.CaseWhen:
+		caseExpr, i := t.enclosing(up)
+		if caseExpr == nil {
+			return unknownColumn()
+		}
+		if node == n.Result {
+			return t.caseResultType(up[:i], caseExpr)
+		}
+		if caseExpr.Arg != nil {
+			return t.typeOf(path, caseExpr.Arg)
+		}
+		return boolColumn(true)
+
+	case *ast.CoalesceExpr:
+		if col := t.firstTyped(path, n.Args.Items...); col.DataType != "any" {
+			return col
+		}
+		return t.contextType(up, n)
+
+	case *ast.MinMaxExpr:
+		if col := t.firstTyped(path, n.Args.Items...); col.DataType != "any" {
+			return col
+		}
+		return t.contextType(up, n)
+
+	case *ast.JoinExpr:
+		if node == n.Quals {
+			return boolColumn(true)
+		}
+
+	case *ast.SelectStmt:
+		switch node {
+		case n.WhereClause, n.HavingClause:
+			return boolColumn(true)
+		case n.LimitCount, n.LimitOffset:
+			return &Column{DataType: "integer", NotNull: true}
+		}
+
+	case *ast.DeleteStmt:
+		if node == n.WhereClause {
+			return boolColumn(true)
+		}
+
+	case *ast.UpdateStmt:
+		if node == n.WhereClause {
+			return boolColumn(true)
+		}
+
+	case *ast.SubLink:
+		if node == n.Testexpr {
+			return t.subqueryType(n.Subselect)
+		}
+
+	case *ast.TypeCast:
+		if n.TypeName != nil {
+			return qualifiedColumn(t.qc.catalog, n.TypeName)
+		}
+
+	}
+	return unknownColumn()
+}
+
+func (t *paramTyper) aExprType(path []ast.Node, n *ast.A_Expr, node ast.Node) *Column {
+	up := path[:len(path)-1]
+	switch n.Kind {
+
+	case ast.AEXPR_IN, ast.AEXPR_BETWEEN, ast.AEXPR_NOT_BETWEEN,
+		ast.AEXPR_BETWEEN_SYM, ast.AEXPR_NOT_BETWEEN_SYM:
+		// The values of the list and the expression all share a type
+		var values []ast.Node
+		if node != n.Lexpr {
+			values = append(values, n.Lexpr)
+		}
+		if list, ok := n.Rexpr.(*ast.List); ok {
+			values = append(values, list.Items...)
+		} else if node != n.Rexpr {
+			values = append(values, n.Rexpr)
+		}
+		return t.firstTyped(path, values...)
+
+	case ast.AEXPR_OP_ANY, ast.AEXPR_OP_ALL:
+		if node == n.Rexpr {
+			col := t.typeOf(path, n.Lexpr)
+			if col.DataType != "any" {
+				col.IsArray = true
+			}
+			return col
+		}
+		col := t.typeOf(path, n.Rexpr)
+		col.IsArray = false
+		return col
+
+	case ast.AEXPR_PAREN:
+		return t.contextType(up, n)
+	}
+
+	op := astutils.Join(n.Name, "")
+	other := n.Lexpr
+	if node == n.Lexpr {
+		other = n.Rexpr
+	}
+	if _, ok := other.(*ast.TODO); ok || other == nil {
+		// Prefix operators, such as negation, keep the type of their operand
+		return t.contextType(up, n)
+	}
+	switch {
+	case op == "||":
+		return &Column{DataType: "text", NotNull: true}
+	case lang.IsMathematicalOperator(op):
+		col := t.typeOf(path, other)
+		if numericRank(normalizeType(col.DataType)) > 0 {
+			return col
+		}
+		if col.DataType == "any" {
+			return t.contextType(up, n)
+		}
+		return unknownColumn()
+	}
+	col := t.typeOf(path, other)
+	if col.DataType == "any" && (n.Kind == ast.AEXPR_LIKE || n.Kind == ast.AEXPR_ILIKE || n.Kind == ast.AEXPR_SIMILAR) {
+		return &Column{DataType: "text", NotNull: true}
+	}
+	return col
+}
+
+// caseResultType returns the type of the results of a CASE expression, which
+// come from the other results or the context of the expression
+func (t *paramTyper) caseResultType(path []ast.Node, n *ast.CaseExpr) *Column {
+	results := []ast.Node{n.Defresult}
+	for _, item := range n.Args.Items {
+		if when, ok := item.(*ast.CaseWhen); ok {
+			results = append(results, when.Result)
+		}
+	}
+	if col := t.firstTyped(append(path[:len(path):len(path)], n), results...); col.DataType != "any" {
+		return col
+	}
+	return t.contextType(path, n)
+}
+
+// enclosing returns the innermost CASE expression of a path and its position
+func (t *paramTyper) enclosing(path []ast.Node) (*ast.CaseExpr, int) {
+	for i := len(path) - 1; i >= 0; i-- {
+		if n, ok := path[i].(*ast.CaseExpr); ok {
+			return n, i
+		}
+	}
+	return nil, -1
+}
+
+// subqueryType returns the type of the single column of a subquery
+func (t *paramTyper) subqueryType(node ast.Node) *Column {
+	cols, err := outputColumns(t.qc, node)
+	if err != nil || len(cols) != 1 {
+		return unknownColumn()
+	}
+	return typeOf(cols[0])
+}
+
+// firstTyped returns the type of the first expression that has one
+func (t *paramTyper) firstTyped(path []ast.Node, nodes ...ast.Node) *Column {
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		if col := t.typeOf(path, node); col.DataType != "any" {
+			return col
+		}
+	}
+	return unknownColumn()
+}
+
+// typeOf returns the type of an expression. Column references are looked up
+// in the statements of the path, from the innermost to the outermost one, and
+// keep the name of the column.
+func (t *paramTyper) typeOf(path []ast.Node, node ast.Node) *Column {
+	if node == ast.Node(t.ref) {
+		return unknownColumn()
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		switch path[i].(type) {
+		case *ast.SelectStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		default:
+			continue
+		}
+		tables, err := sourceTables(t.qc, path[i])
+		if err != nil {
+			continue
+		}
+		if col := t.typeIn(tables, node); col.DataType != "any" {
+			return col
+		}
+		// The ON CONFLICT clause also sees the row proposed for insertion
+		if insert, ok := path[i].(*ast.InsertStmt); ok && i+1 < len(path) && len(tables) == 1 {
+			if insert.OnConflictClause != nil && path[i+1] == ast.Node(insert.OnConflictClause) {
+				if col := t.typeIn([]*Table{excludedTable(tables[0])}, node); col.DataType != "any" {
+					return col
+				}
+			}
+		}
+	}
+	return unknownColumn()
+}
+
+func (t *paramTyper) typeIn(tables []*Table, node ast.Node) *Column {
+	res := &ast.ResTarget{}
+	if ref, ok := node.(*ast.ColumnRef); ok && !hasStarRef(ref) {
+		cols, err := outputColumnRefs(res, tables, ref)
+		if err == nil && len(cols) == 1 {
+			return cols[0]
+		}
+		return unknownColumn()
+	}
+	col := exprColumn(t.qc, tables, res, node)
+	col.Name = ""
+	return col
+}
+
+// excludedTable returns the excluded pseudo-relation of an INSERT ... ON
+// CONFLICT, which has the columns of the table that's inserted into
+func excludedTable(t *Table) *Table {
+	return &Table{Rel: &ast.TableName{Name: "excluded"}, Columns: t.Columns}
+}
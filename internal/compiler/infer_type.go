@@ -0,0 +1,533 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/lang"
+)
+
+// exprColumn infers the type and nullability of an expression in a target
+// list. Expressions that can't be typed result in a nullable "any" column.
+func exprColumn(qc *QueryCatalog, tables []*Table, res *ast.ResTarget, node ast.Node) *Column {
+	t := &exprTyper{qc: qc, tables: tables, res: res}
+	col := t.infer(node)
+	col.Name = exprName(res, node)
+	return col
+}
+
+// The name of an expression in a target list, if it isn't given an alias
+func exprName(res *ast.ResTarget, node ast.Node) string {
+	if res.Name != nil {
+		return *res.Name
+	}
+	switch n := node.(type) {
+	case *ast.CoalesceExpr:
+		for _, arg := range n.Args.Items {
+			if ref, ok := arg.(*ast.ColumnRef); ok {
+				if parts := stringSlice(ref.Fields); len(parts) > 0 {
+					return parts[len(parts)-1]
+				}
+			}
+		}
+		return "coalesce"
+	case *ast.FuncCall:
+		return n.Func.Name
+	case *ast.SubLink:
+		return "exists"
+	case *ast.TypeCast:
+		if ref, ok := n.Arg.(*ast.ColumnRef); ok {
+			return astutils.Join(ref.Fields, "_")
+		}
+	}
+	return ""
+}
+
+type exprTyper struct {
+	qc     *QueryCatalog
+	tables []*Table
+	res    *ast.ResTarget
+}
+
+func unknownColumn() *Column {
+	return &Column{DataType: "any"}
+}
+
+func boolColumn(notNull bool) *Column {
+	return &Column{DataType: "bool", NotNull: notNull}
+}
+
+// typeOf copies the type of a column, but not its name or table
+func typeOf(c *Column) *Column {
+	return &Column{
+		DataType: c.DataType,
+		NotNull:  c.NotNull,
+		IsArray:  c.IsArray,
+		Length:   c.Length,
+		Type:     c.Type,
+	}
+}
+
+func (t *exprTyper) infer(node ast.Node) *Column {
+	switch n := node.(type) {
+
+	case *ast.A_Const:
+		switch n.Val.(type) {
+		case *ast.Integer:
+			return &Column{DataType: "integer", NotNull: true}
+		case *ast.Float:
+			return &Column{DataType: "numeric", NotNull: true}
+		case *ast.String:
+			return &Column{DataType: "text", NotNull: true, untyped: true}
+		}
+		return unknownColumn()
+
+	case *ast.A_Expr:
+		return t.aExpr(n)
+
+	case *ast.BoolExpr:
+		return boolColumn(t.notNull(n.Args))
+
+	case *ast.BooleanTest, *ast.NullTest:
+		return boolColumn(true)
+
+	case *ast.CaseExpr:
+		col := unknownColumn()
+		notNull := n.Defresult != nil
+		results := []ast.Node{n.Defresult}
+		for _, item := range n.Args.Items {
+			if when, ok := item.(*ast.CaseWhen); ok {
+				results = append(results, when.Result)
+			}
+		}
+		for _, result := range results {
+			c := t.infer(result)
+			if col.DataType == "any" && c.DataType != "any" {
+				col = typeOf(c)
+			}
+			notNull = notNull && c.NotNull
+		}
+		col.NotNull = notNull
+		return col
+
+	case *ast.CoalesceExpr:
+		return t.firstNotNull(n.Args)
+
+	case *ast.ColumnRef:
+		if hasStarRef(n) {
+			return unknownColumn()
+		}
+		cols, err := outputColumnRefs(t.res, t.tables, n)
+		if err != nil || len(cols) != 1 {
+			return unknownColumn()
+		}
+		return typeOf(cols[0])
+
+	case *ast.FuncCall:
+		return t.funcCall(n)
+
+	case *ast.MinMaxExpr:
+		// GREATEST and LEAST ignore NULL arguments
+		return t.firstNotNull(n.Args)
+
+	case *ast.ParamRef:
+		// The type of a parameter comes from its context. It's assumed to be
+		// set, like parameters everywhere else.
+		return &Column{DataType: "any", NotNull: true}
+
+	case *ast.SelectStmt:
+		// MySQL scalar subqueries aren't wrapped in a SubLink
+		return t.subquery(n, false)
+
+	case *ast.SubLink:
+		switch n.SubLinkType {
+		case ast.EXISTS_SUBLINK:
+			return boolColumn(true)
+		case ast.EXPR_SUBLINK:
+			return t.subquery(n.Subselect, false)
+		case ast.ARRAY_SUBLINK:
+			return t.subquery(n.Subselect, true)
+		default:
+			return boolColumn(false)
+		}
+
+	case *ast.TypeCast:
+		if n.TypeName == nil {
+			return unknownColumn()
+		}
+		col := qualifiedColumn(t.qc.catalog, n.TypeName)
+		col.NotNull = t.infer(n.Arg).NotNull
+		return col
+
+	}
+	return unknownColumn()
+}
+
+// notNull reports whether none of the expressions can be NULL
+func (t *exprTyper) notNull(list *ast.List) bool {
+	if list == nil {
+		return true
+	}
+	for _, item := range list.Items {
+		if inner, ok := item.(*ast.List); ok {
+			if !t.notNull(inner) {
+				return false
+			}
+			continue
+		}
+		if !t.infer(item).NotNull {
+			return false
+		}
+	}
+	return true
+}
+
+// firstNotNull types expressions like COALESCE, which result in the first
+// argument that isn't NULL
+func (t *exprTyper) firstNotNull(list *ast.List) *Column {
+	col := unknownColumn()
+	if list == nil {
+		return col
+	}
+	var notNull bool
+	for _, arg := range list.Items {
+		c := t.infer(arg)
+		if col.DataType == "any" && c.DataType != "any" {
+			col = typeOf(c)
+		}
+		notNull = notNull || c.NotNull
+	}
+	col.NotNull = notNull
+	return col
+}
+
+// A scalar subquery is NULL when it returns no rows. An ARRAY() subquery
+// returns an empty array instead.
+func (t *exprTyper) subquery(node ast.Node, array bool) *Column {
+	cols, err := outputColumns(t.qc, node)
+	if err != nil || len(cols) != 1 {
+		return unknownColumn()
+	}
+	col := typeOf(cols[0])
+	if array {
+		col.IsArray = true
+		col.NotNull = true
+	} else {
+		col.NotNull = false
+	}
+	return col
+}
+
+func (t *exprTyper) aExpr(n *ast.A_Expr) *Column {
+	switch n.Kind {
+	case ast.AEXPR_DISTINCT, ast.AEXPR_NOT_DISTINCT:
+		return boolColumn(true)
+	case ast.AEXPR_NULLIF:
+		col := typeOf(t.infer(n.Lexpr))
+		col.NotNull = false
+		return col
+	case ast.AEXPR_OP_ANY, ast.AEXPR_OP_ALL, ast.AEXPR_IN,
+		ast.AEXPR_LIKE, ast.AEXPR_ILIKE, ast.AEXPR_SIMILAR,
+		ast.AEXPR_BETWEEN, ast.AEXPR_NOT_BETWEEN,
+		ast.AEXPR_BETWEEN_SYM, ast.AEXPR_NOT_BETWEEN_SYM:
+		return boolColumn(t.notNull(&ast.List{Items: []ast.Node{n.Lexpr, n.Rexpr}}))
+	}
+
+	op := astutils.Join(n.Name, "")
+	if _, ok := n.Lexpr.(*ast.TODO); ok || n.Lexpr == nil {
+		// Prefix operators, such as negation, keep the type of their operand
+		return typeOf(t.infer(n.Rexpr))
+	}
+	l, r := t.infer(n.Lexpr), t.infer(n.Rexpr)
+	notNull := l.NotNull && r.NotNull
+	switch {
+	case lang.IsComparisonOperator(op) || isPredicateOperator(op):
+		return boolColumn(notNull)
+	case op == "||":
+		col := &Column{DataType: "text"}
+		switch {
+		case l.IsArray:
+			col = typeOf(l)
+		case r.IsArray:
+			col = typeOf(r)
+		case isJSON(l.DataType):
+			col = typeOf(l)
+		}
+		col.NotNull = notNull
+		return col
+	case op == "->" || op == "#>":
+		col := typeOf(l)
+		col.NotNull = false
+		return col
+	case op == "->>" || op == "#>>":
+		return &Column{DataType: "text"}
+	case lang.IsMathematicalOperator(op):
+		col := arithmetic(op, l, r)
+		if col.DataType != "any" {
+			col.NotNull = notNull
+		}
+		return col
+	}
+	return unknownColumn()
+}
+
+// Operators, other than comparisons, that always result in a boolean
+func isPredicateOperator(op string) bool {
+	switch op {
+	case "~~", "~~*", "!~~", "!~~*", "~*", "!~", "!~*":
+	case "@>", "<@", "&&", "?", "?|", "?&", "@@":
+	default:
+		return false
+	}
+	return true
+}
+
+// The result type of an arithmetic operator follows the usual numeric
+// promotions, plus the handful of date and time operators.
+func arithmetic(op string, l, r *Column) *Column {
+	if l.IsArray || r.IsArray {
+		return unknownColumn()
+	}
+	lt, rt := normalizeType(l.DataType), normalizeType(r.DataType)
+	// Parameters and other untyped operands take the type of the other side
+	switch {
+	case lt == "any" && rt == "any":
+		return unknownColumn()
+	case lt == "any":
+		return typeOf(r)
+	case rt == "any":
+		return typeOf(l)
+	}
+	if numericRank(lt) > 0 && numericRank(rt) > 0 {
+		if numericRank(rt) > numericRank(lt) {
+			return typeOf(r)
+		}
+		return typeOf(l)
+	}
+	switch {
+	case op == "~" && isText(lt) && isText(rt):
+		// POSIX regular expression match
+		return boolColumn(false)
+	case op == "-" && lt == "date" && rt == "date":
+		return &Column{DataType: "integer"}
+	case op == "-" && isTimestamp(lt) && isTimestamp(rt):
+		return &Column{DataType: "interval"}
+	case (op == "+" || op == "-") && isDateTime(lt) && (rt == "interval" || numericRank(rt) > 0):
+		return typeOf(l)
+	case op == "+" && isDateTime(rt) && (lt == "interval" || numericRank(lt) > 0):
+		return typeOf(r)
+	case (op == "+" || op == "-") && isNetwork(lt) && numericRank(rt) > 0:
+		return typeOf(l)
+	case op == "+" && isNetwork(rt) && numericRank(lt) > 0:
+		return typeOf(r)
+	case op == "-" && isNetwork(lt) && isNetwork(rt):
+		return &Column{DataType: "bigint"}
+	case lt == "interval" && (rt == "interval" || numericRank(rt) > 0):
+		return typeOf(l)
+	case rt == "interval" && numericRank(lt) > 0 && op == "*":
+		return typeOf(r)
+	}
+	return unknownColumn()
+}
+
+func (t *exprTyper) funcCall(n *ast.FuncCall) *Column {
+	fun, err := t.qc.catalog.ResolveFuncCall(n)
+	if err != nil {
+		return unknownColumn()
+	}
+	var args []*Column
+	if n.Args != nil {
+		for _, item := range n.Args.Items {
+			if narg, ok := item.(*ast.NamedArgExpr); ok {
+				item = narg.Arg
+			}
+			args = append(args, t.infer(item))
+		}
+	}
+	if funs, err := t.qc.catalog.ListFuncsByName(n.Func); err == nil {
+		if match := matchFuncArgs(funs, args); match != nil {
+			fun = match
+		}
+	}
+
+	col := returnColumn(fun, args)
+	switch {
+	case fun.ReturnTypeNullable:
+		col.NotNull = false
+	case fun.Strict && !n.AggStar:
+		col.NotNull = true
+		for _, arg := range args {
+			col.NotNull = col.NotNull && arg.NotNull
+		}
+	default:
+		col.NotNull = true
+	}
+	return col
+}
+
+// matchFuncArgs returns the overload whose argument types match the types of
+// the given arguments. It returns nil if no overload matches.
+func matchFuncArgs(funs []catalog.Function, args []*Column) *catalog.Function {
+	var best *catalog.Function
+	bestScore := -1
+	for i := range funs {
+		params := funs[i].InArgs()
+		if len(params) != len(args) {
+			continue
+		}
+		score := 0
+		for j, param := range params {
+			want := normalizeType(dataType(param.Type))
+			if isArray(param.Type) {
+				want += "[]"
+			}
+			have := normalizeType(args[j].DataType)
+			if args[j].IsArray {
+				have += "[]"
+			}
+			if want == have {
+				score += 1
+				continue
+			}
+			if !isPolymorphic(want) {
+				score = -1
+				break
+			}
+		}
+		if score > bestScore {
+			best, bestScore = &funs[i], score
+		}
+	}
+	return best
+}
+
+// returnColumn resolves the return type of a function. Polymorphic return
+// types, such as anyelement, take the type of the matching argument.
+func returnColumn(fun *catalog.Function, args []*Column) *Column {
+	if fun.ReturnType == nil {
+		return unknownColumn()
+	}
+	rt := dataType(fun.ReturnType)
+	col := &Column{DataType: rt, IsArray: isArray(fun.ReturnType)}
+	if strings.HasSuffix(rt, "[]") {
+		col.DataType = strings.TrimSuffix(rt, "[]")
+		col.IsArray = true
+	}
+	if !isPolymorphic(normalizeType(rt)) {
+		return col
+	}
+	for i, param := range fun.InArgs() {
+		if i >= len(args) || args[i].DataType == "any" {
+			continue
+		}
+		pt := normalizeType(dataType(param.Type))
+		if !isPolymorphic(pt) {
+			continue
+		}
+		arg := typeOf(args[i])
+		switch {
+		case isPolymorphicArray(rt) && !isPolymorphicArray(pt):
+			arg.IsArray = true
+		case !isPolymorphicArray(rt) && isPolymorphicArray(pt):
+			arg.IsArray = false
+		}
+		return arg
+	}
+	return unknownColumn()
+}
+
+func isPolymorphic(name string) bool {
+	switch name {
+	case "any", "anyelement", "anynonarray", "anyenum", "anyarray",
+		"anycompatible", "anycompatiblenonarray", "anycompatiblearray":
+		return true
+	}
+	return false
+}
+
+func isPolymorphicArray(name string) bool {
+	return name == "anyarray" || name == "anycompatiblearray"
+}
+
+// normalizeType maps the many spellings of the builtin types onto the names
+// used by pg_catalog
+func normalizeType(name string) string {
+	name = strings.TrimPrefix(strings.ToLower(name), "pg_catalog.")
+	switch name {
+	case "int2", "smallserial", "serial2", "tinyint", "mediumint":
+		return "smallint"
+	case "int", "int4", "serial", "serial4":
+		return "integer"
+	case "int8", "bigserial", "serial8":
+		return "bigint"
+	case "decimal", "dec", "fixed":
+		return "numeric"
+	case "float4", "float":
+		return "real"
+	case "float8", "double":
+		return "double precision"
+	case "bool":
+		return "boolean"
+	case "varchar":
+		return "character varying"
+	case "bpchar", "char":
+		return "character"
+	case "timestamp", "datetime":
+		return "timestamp without time zone"
+	case "timestamptz":
+		return "timestamp with time zone"
+	case "time":
+		return "time without time zone"
+	case "timetz":
+		return "time with time zone"
+	}
+	return name
+}
+
+func numericRank(name string) int {
+	switch name {
+	case "smallint":
+		return 1
+	case "integer":
+		return 2
+	case "bigint":
+		return 3
+	case "numeric":
+		return 4
+	case "real":
+		return 5
+	case "double precision":
+		return 6
+	}
+	return 0
+}
+
+func isText(name string) bool {
+	switch name {
+	case "text", "character varying", "character", "citext":
+		return true
+	}
+	return false
+}
+
+func isJSON(name string) bool {
+	switch normalizeType(name) {
+	case "json", "jsonb":
+		return true
+	}
+	return false
+}
+
+func isTimestamp(name string) bool {
+	return name == "timestamp without time zone" || name == "timestamp with time zone"
+}
+
+func isDateTime(name string) bool {
+	return name == "date" || isTimestamp(name)
+}
+
+func isNetwork(name string) bool {
+	return name == "inet" || name == "cidr"
+}
@@ -1,12 +1,12 @@
 package compiler
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/astutils"
-	"github.com/kyleconroy/sqlc/internal/sql/lang"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/rewrite"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 )
 
@@ -19,6 +19,44 @@ func hasStarRef(cf *ast.ColumnRef) bool {
 	return false
 }
 
+// OutputColumns computes the output columns of a statement and converts them
+// into catalog columns. It's used to build the catalog entries for views.
+func (c *Compiler) OutputColumns(stmt ast.Node) ([]*catalog.Column, error) {
+	qc, err := buildQueryCatalog(c.catalog, c.conf.Engine, stmt, nil)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := outputColumns(qc, stmt)
+	if err != nil {
+		return nil, err
+	}
+	catCols := make([]*catalog.Column, 0, len(cols))
+	for _, col := range cols {
+		catCols = append(catCols, catalogColumn(col))
+	}
+	return catCols, nil
+}
+
+// catalogColumn converts an output column back into a catalog column
+func catalogColumn(col *Column) *catalog.Column {
+	var typ ast.TypeName
+	if col.Type != nil {
+		typ = *col.Type
+	} else if rel, err := ParseRelationString(col.DataType); err == nil {
+		typ = ast.TypeName{Catalog: rel.Catalog, Schema: rel.Schema, Name: rel.Name}
+	} else {
+		typ = ast.TypeName{Name: col.DataType}
+	}
+	return &catalog.Column{
+		Name:      col.Name,
+		Type:      typ,
+		IsNotNull: col.NotNull,
+		IsArray:   col.IsArray,
+		Comment:   col.Comment,
+		Length:    col.Length,
+	}
+}
+
 // Compute the output columns for a statement.
 //
 // Return an error if column references are ambiguous
@@ -37,12 +75,14 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 		targets = n.ReturningList
 	case *ast.SelectStmt:
 		targets = n.TargetList
-		// For UNION queries, targets is empty and we need to look for the
-		// columns in Largs.
+		// UNION, INTERSECT and EXCEPT queries have no targets of their own
+		if n.Op != ast.None && n.Larg != nil && n.Rarg != nil {
+			return setOpColumns(qc, n)
+		}
 		if len(targets.Items) == 0 && n.Larg != nil {
 			return outputColumns(qc, n.Larg)
 		}
-	case *ast.TruncateStmt:
+	case *ast.CallStmt, *ast.TruncateStmt:
 		targets = &ast.List{}
 	case *ast.UpdateStmt:
 		targets = n.ReturningList
@@ -59,69 +99,15 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 		}
 		switch n := res.Val.(type) {
 
-		case *ast.A_Expr:
-			name := ""
-			if res.Name != nil {
-				name = *res.Name
-			}
-			switch {
-			case lang.IsComparisonOperator(astutils.Join(n.Name, "")):
-				// TODO: Generate a name for these operations
-				cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
-			case lang.IsMathematicalOperator(astutils.Join(n.Name, "")):
-				cols = append(cols, &Column{Name: name, DataType: "int", NotNull: true})
-			default:
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
-			}
-
-		case *ast.CaseExpr:
-			name := ""
-			if res.Name != nil {
-				name = *res.Name
-			}
-			// TODO: The TypeCase code has been copied from below. Instead, we need a recurse function to get the type of a node.
-			if tc, ok := n.Defresult.(*ast.TypeCast); ok {
-				if tc.TypeName == nil {
-					return nil, errors.New("no type name type cast")
-				}
-				name := ""
-				if ref, ok := tc.Arg.(*ast.ColumnRef); ok {
-					name = astutils.Join(ref.Fields, "_")
-				}
-				if res.Name != nil {
-					name = *res.Name
+		case *ast.ColumnRef:
+			if embed, ok := qc.embeds.Find(n); ok {
+				col, err := embedColumn(qc, res, tables, embed)
+				if err != nil {
+					return nil, err
 				}
-				// TODO Validate column names
-				col := toColumn(tc.TypeName)
-				col.Name = name
 				cols = append(cols, col)
-			} else {
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
-			}
-
-		case *ast.CoalesceExpr:
-			var found bool
-			for _, arg := range n.Args.Items {
-				if found {
-					continue
-				}
-				if ref, ok := arg.(*ast.ColumnRef); ok {
-					columns, err := outputColumnRefs(res, tables, ref)
-					if err != nil {
-						return nil, err
-					}
-					for _, c := range columns {
-						found = true
-						c.NotNull = true
-						cols = append(cols, c)
-					}
-				}
-			}
-			if !found {
-				cols = append(cols, &Column{Name: "coalesce", DataType: "any", NotNull: false})
+				continue
 			}
-
-		case *ast.ColumnRef:
 			if hasStarRef(n) {
 				// TODO: This code is copied in func expand()
 				for _, t := range tables {
@@ -155,106 +141,96 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 			}
 			cols = append(cols, columns...)
 
-		case *ast.FuncCall:
-			rel := n.Func
-			name := rel.Name
-			if res.Name != nil {
-				name = *res.Name
-			}
-			fun, err := qc.catalog.ResolveFuncCall(n)
-			if err == nil {
-				cols = append(cols, &Column{Name: name, DataType: dataType(fun.ReturnType), NotNull: true})
-			} else {
-				cols = append(cols, &Column{Name: name, DataType: "any"})
-			}
-
-		case *ast.SubLink:
-			name := "exists"
-			if res.Name != nil {
-				name = *res.Name
-			}
-			switch n.SubLinkType {
-			case ast.EXISTS_SUBLINK:
-				cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
-			default:
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
-			}
-
-		case *ast.TypeCast:
-			if n.TypeName == nil {
-				return nil, errors.New("no type name type cast")
-			}
-			name := ""
-			if ref, ok := n.Arg.(*ast.ColumnRef); ok {
-				name = astutils.Join(ref.Fields, "_")
-			}
-			if res.Name != nil {
-				name = *res.Name
-			}
-			// TODO Validate column names
-			col := toColumn(n.TypeName)
-			col.Name = name
-			cols = append(cols, col)
-
 		default:
-			name := ""
-			if res.Name != nil {
-				name = *res.Name
-			}
-			cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
+			cols = append(cols, exprColumn(qc, tables, res, n))
 
 		}
 	}
 
-	if n, ok := node.(*ast.SelectStmt); ok {
-		for _, col := range cols {
-			if !col.NotNull || col.Table == nil {
-				continue
+	return cols, nil
+}
+
+// sqlc.embed(t) results in a single column that holds all of the columns of t
+func embedColumn(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, embed *rewrite.Embed) (*Column, error) {
+	for _, t := range tables {
+		if t.Rel.Name != embed.Table.Name {
+			continue
+		}
+		// The table may be aliased, but the columns still refer to the
+		// original relation
+		rel := t.Rel
+		if len(t.Columns) > 0 && t.Columns[0].Table != nil {
+			rel = t.Columns[0].Table
+		}
+		// A model struct can't represent the missing row of an outer join
+		if t.Nullable {
+			return nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("sqlc.embed(%s) can't be used on the nullable side of an outer join", embed.Table.Name),
+				Location: res.Location,
 			}
-			for _, f := range n.FromClause.Items {
-				if res := isTableRequired(f, col.Table.Name, tableRequired); res != tableNotFound {
-					col.NotNull = res == tableRequired
-					break
-				}
+		}
+		// Only whole tables and views map onto a model struct
+		if src, err := qc.catalog.GetTable(rel); err != nil || len(src.Columns) != len(t.Columns) {
+			return nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("sqlc.embed(%s) must refer to a table or view", embed.Table.Name),
+				Location: res.Location,
 			}
 		}
+		return &Column{
+			Name:       embed.Table.Name,
+			Table:      rel,
+			EmbedTable: rel,
+			NotNull:    true,
+		}, nil
+	}
+	return nil, &sqlerr.Error{
+		Code:     "42P01",
+		Message:  fmt.Sprintf("missing FROM-clause entry for table \"%s\"", embed.Table.Name),
+		Location: res.Location,
 	}
-
-	return cols, nil
 }
 
-const (
-	tableNotFound = iota
-	tableRequired
-	tableOptional
-)
-
-func isTableRequired(n ast.Node, tableName string, prior int) int {
+// outerJoinRelations records the relations in a FROM clause that are on the
+// nullable side of an outer join. Relations nested inside such a join are
+// nullable as well, no matter how they are joined to each other.
+func outerJoinRelations(n ast.Node, nullable bool, rels map[ast.Node]bool) {
 	switch n := n.(type) {
-	case *ast.RangeVar:
-		if *n.Relname == tableName {
-			return prior
+	case *ast.List:
+		if n == nil {
+			return
 		}
-	case *ast.JoinExpr:
-		helper := func(l, r int) int {
-			if res := isTableRequired(n.Larg, tableName, l); res != tableNotFound {
-				return res
-			}
-			if res := isTableRequired(n.Rarg, tableName, r); res != tableNotFound {
-				return res
-			}
-			return tableNotFound
+		for _, item := range n.Items {
+			outerJoinRelations(item, nullable, rels)
 		}
+	case *ast.JoinExpr:
+		left, right := nullable, nullable
 		switch n.Jointype {
 		case ast.JoinTypeLeft:
-			return helper(tableRequired, tableOptional)
+			right = true
 		case ast.JoinTypeRight:
-			return helper(tableOptional, tableRequired)
+			left = true
 		case ast.JoinTypeFull:
-			return helper(tableOptional, tableOptional)
+			left, right = true, true
+		}
+		outerJoinRelations(n.Larg, left, rels)
+		outerJoinRelations(n.Rarg, right, rels)
+	case *ast.RangeVar, *ast.RangeSubselect, *ast.RangeFunction:
+		if nullable {
+			rels[n] = true
 		}
 	}
-	return tableNotFound
+}
+
+// nullableTable returns a copy of the table with every column marked as
+// nullable
+func nullableTable(t *Table) *Table {
+	cols := make([]*Column, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		col := *c
+		col.NotNull = false
+		cols = append(cols, &col)
+	}
+	return &Table{Rel: t.Rel, Columns: cols, Nullable: true}
 }
 
 // Compute the output columns for a statement.
@@ -265,11 +241,23 @@ func isTableRequired(n ast.Node, tableName string, prior int) int {
 // Return an error if an unknown column is referenced
 func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	var list *ast.List
+	nullable := map[ast.Node]bool{}
 	switch n := node.(type) {
 	case *ast.DeleteStmt:
 		list = &ast.List{
 			Items: []ast.Node{n.Relation},
 		}
+		if n.UsingClause != nil {
+			using := astutils.Search(n.UsingClause, func(node ast.Node) bool {
+				switch node.(type) {
+				case *ast.RangeVar, *ast.RangeSubselect:
+					return true
+				default:
+					return false
+				}
+			})
+			list.Items = append(list.Items, using.Items...)
+		}
 	case *ast.InsertStmt:
 		list = &ast.List{
 			Items: []ast.Node{n.Relation},
@@ -277,12 +265,15 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	case *ast.SelectStmt:
 		list = astutils.Search(n.FromClause, func(node ast.Node) bool {
 			switch node.(type) {
-			case *ast.RangeVar, *ast.RangeSubselect, *ast.FuncName:
+			case *ast.RangeVar, *ast.RangeSubselect, *ast.RangeFunction:
 				return true
 			default:
 				return false
 			}
 		})
+		outerJoinRelations(n.FromClause, false, nullable)
+	case *ast.CallStmt:
+		list = &ast.List{}
 	case *ast.TruncateStmt:
 		list = astutils.Search(n.Relations, func(node ast.Node) bool {
 			_, ok := node.(*ast.RangeVar)
@@ -300,19 +291,9 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	for _, item := range list.Items {
 		switch n := item.(type) {
 
-		case *ast.FuncName:
-			// If the function or table can't be found, don't error out.  There
-			// are many queries that depend on functions unknown to sqlc.
-			fn, err := qc.GetFunc(n)
-			if err != nil {
-				continue
-			}
-			table, err := qc.GetTable(&ast.TableName{
-				Catalog: fn.ReturnType.Catalog,
-				Schema:  fn.ReturnType.Schema,
-				Name:    fn.ReturnType.Name,
-			})
-			if err != nil {
+		case *ast.RangeFunction:
+			table := rangeFunctionTable(qc, tables, n)
+			if table == nil {
 				continue
 			}
 			tables = append(tables, table)
@@ -353,6 +334,11 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 		default:
 			return nil, fmt.Errorf("sourceTable: unsupported list item type: %T", n)
 		}
+
+		// Columns from the nullable side of an outer join may be NULL
+		if nullable[item] {
+			tables[len(tables)-1] = nullableTable(tables[len(tables)-1])
+		}
 	}
 	return tables, nil
 }
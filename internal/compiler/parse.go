@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/kyleconroy/sqlc/internal/config"
 	"github.com/kyleconroy/sqlc/internal/debug"
 	"github.com/kyleconroy/sqlc/internal/metadata"
 	"github.com/kyleconroy/sqlc/internal/opts"
@@ -18,14 +19,29 @@ import (
 
 var ErrUnsupportedStatementType = errors.New("parseQuery: unsupported statement type")
 
-func rewriteNumberedParameters(refs []paramRef, raw *ast.RawStmt, sql string) ([]source.Edit, error) {
-	edits := make([]source.Edit, len(refs))
-	for i, ref := range refs {
-		edits[i] = source.Edit{
-			Location: ref.ref.Location - raw.StmtLocation,
+// rewriteNumberedParameters replaces the numbered parameters of a statement
+// with question marks. Named parameters have already been edited, and only
+// need a question mark in place of their number.
+func rewriteNumberedParameters(refs []paramRef, raw *ast.RawStmt, named []source.Edit) ([]source.Edit, error) {
+	edited := map[int]bool{}
+	edits := make([]source.Edit, 0, len(refs)+len(named))
+	for _, edit := range named {
+		if strings.HasPrefix(edit.New, "$") {
+			edit.New = "?"
+		}
+		edited[edit.Location] = true
+		edits = append(edits, edit)
+	}
+	for _, ref := range refs {
+		loc := ref.ref.Location - raw.StmtLocation
+		if edited[loc] {
+			continue
+		}
+		edits = append(edits, source.Edit{
+			Location: loc,
 			Old:      fmt.Sprintf("$%d", ref.ref.Number),
 			New:      "?",
-		}
+		})
 	}
 	return edits, nil
 }
@@ -45,12 +61,19 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		return nil, errors.New("node is not a statement")
 	}
 	switch n := raw.Stmt.(type) {
+	case *ast.CallStmt:
+		if err := validate.CallStmt(c.catalog, n); err != nil {
+			return nil, err
+		}
 	case *ast.SelectStmt:
 	case *ast.DeleteStmt:
 	case *ast.InsertStmt:
 		if err := validate.InsertStmt(n); err != nil {
 			return nil, err
 		}
+		if err := validate.OnConflict(c.catalog, n); err != nil {
+			return nil, err
+		}
 	case *ast.TruncateStmt:
 	case *ast.UpdateStmt:
 	default:
@@ -67,6 +90,15 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err := validate.FuncCall(c.catalog, raw); err != nil {
 		return nil, err
 	}
+	if err := validate.SqlcSlice(raw); err != nil {
+		return nil, err
+	}
+	if err := validate.GeneratedColumns(c.catalog, raw.Stmt); err != nil {
+		return nil, err
+	}
+	if err := validate.Sequences(c.catalog, raw.Stmt); err != nil {
+		return nil, err
+	}
 	name, cmd, err := metadata.Parse(strings.TrimSpace(rawSQL), c.parser.CommentSyntax())
 	if err != nil {
 		return nil, err
@@ -74,32 +106,79 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err := validate.Cmd(raw.Stmt, name, cmd); err != nil {
 		return nil, err
 	}
+	annotations, err := metadata.ParseAnnotations(strings.TrimSpace(rawSQL), c.parser.CommentSyntax())
+	if err != nil {
+		return nil, err
+	}
+	if metadata.IsBatch(cmd) && !c.usesPgx() {
+		return nil, fmt.Errorf("query %q uses %s, which requires the postgresql engine and the %s sql_package", name, cmd, config.SQLPackagePGX)
+	}
 
-	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw)
+	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw, rawSQL)
+	raw, embeds := rewrite.Embeds(raw)
+	if rewrite.IsEmbedUnresolved(raw) {
+		return nil, fmt.Errorf("query %q uses sqlc.embed() outside of the target list", name)
+	}
+	for _, p := range namedParams {
+		if p.IsSqlcSlice && c.conf.Engine == config.EnginePostgreSQL {
+			return nil, fmt.Errorf("query %q uses sqlc.slice(%s), which is not supported by the postgresql engine; use = ANY($1::type[]) instead", name, p.Name)
+		}
+	}
+	if cmd == metadata.CmdCopyFrom {
+		if c.conf.Engine != config.EnginePostgreSQL {
+			return nil, fmt.Errorf("query %q uses %s, which requires the postgresql engine", name, cmd)
+		}
+		if err := validate.CopyFrom(raw.Stmt, name); err != nil {
+			return nil, err
+		}
+	}
 	rvs := rangeVars(raw.Stmt)
 	refs := findParameters(raw.Stmt)
+	var placeholders []int
 	if o.UsePositionalParameters {
-		edits, err = rewriteNumberedParameters(refs, raw, rawSQL)
+		edits, err = rewriteNumberedParameters(refs, raw, edits)
 		if err != nil {
 			return nil, err
 		}
 	} else {
+		if c.conf.Engine == config.EngineMySQL {
+			placeholders = placeholderNumbers(refs)
+		}
 		refs = uniqueParamRefs(refs)
 		sort.Slice(refs, func(i, j int) bool { return refs[i].ref.Number < refs[j].ref.Number })
 	}
-	params, err := resolveCatalogRefs(c.catalog, rvs, refs, namedParams)
+	qc, err := buildQueryCatalog(c.catalog, c.conf.Engine, raw.Stmt, embeds)
 	if err != nil {
 		return nil, err
 	}
-
-	qc, err := buildQueryCatalog(c.catalog, raw.Stmt)
+	params, err := resolveCatalogRefs(c.catalog, qc, rvs, refs, namedParams)
 	if err != nil {
 		return nil, err
 	}
+	if metadata.IsBatch(cmd) && len(params) == 0 {
+		return nil, fmt.Errorf("query %q uses %s but has no parameters to batch", name, cmd)
+	}
+	var table *ast.TableName
+	if insert, ok := raw.Stmt.(*ast.InsertStmt); ok && cmd == metadata.CmdCopyFrom {
+		table, err = ParseTableName(insert.Relation)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range params {
+			if p.Column == nil || p.Column.OriginalName == "" {
+				return nil, fmt.Errorf("query %q uses %s, but parameter $%d is not bound to a column", name, cmd, p.Number)
+			}
+		}
+	}
+
 	cols, err := outputColumns(qc, raw.Stmt)
 	if err != nil {
 		return nil, err
 	}
+	paramTypes, colTypes, err := annotate(name, annotations, params, cols, namedParams)
+	if err != nil {
+		return nil, err
+	}
 
 	expandEdits, err := c.expand(qc, raw)
 	if err != nil {
@@ -119,10 +198,17 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		}
 	}
 
-	trimmed, comments, err := source.StripComments(expanded)
+	trimmed, stripped, err := source.StripComments(expanded)
 	if err != nil {
 		return nil, err
 	}
+	// Annotations are directives for sqlc, not documentation of the query
+	var comments []string
+	for _, comment := range stripped {
+		if !metadata.IsAnnotation(comment) {
+			comments = append(comments, comment)
+		}
+	}
 
 	return &Query{
 		Cmd:      cmd,
@@ -131,6 +217,13 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		Params:   params,
 		Columns:  cols,
 		SQL:      trimmed,
+
+		Placeholders: placeholders,
+
+		ParamTypes:  paramTypes,
+		ColumnTypes: colTypes,
+
+		InsertIntoTable: table,
 	}, nil
 }
 
@@ -146,6 +239,28 @@ func rangeVars(root ast.Node) []*ast.RangeVar {
 	return vars
 }
 
+// placeholderNumbers returns the numbers of the parameters that the ?
+// placeholders of a query are bound to, in order. It's nil unless a named
+// parameter is used more than once, as each placeholder is bound to its own
+// parameter otherwise.
+func placeholderNumbers(refs []paramRef) []int {
+	sorted := make([]paramRef, len(refs))
+	copy(sorted, refs)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ref.Location < sorted[j].ref.Location })
+	var repeated bool
+	seen := map[int]bool{}
+	numbers := make([]int, 0, len(sorted))
+	for _, ref := range sorted {
+		repeated = repeated || seen[ref.ref.Number]
+		seen[ref.ref.Number] = true
+		numbers = append(numbers, ref.ref.Number)
+	}
+	if !repeated {
+		return nil
+	}
+	return numbers
+}
+
 func uniqueParamRefs(in []paramRef) []paramRef {
 	m := make(map[int]struct{}, len(in))
 	o := make([]paramRef, 0, len(in))
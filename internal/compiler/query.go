@@ -4,23 +4,28 @@ import (
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 )
 
-type Function struct {
-	Rel        *ast.FuncName
-	ReturnType *ast.TypeName
-}
-
 type Table struct {
 	Rel     *ast.TableName
 	Columns []*Column
+
+	Nullable bool // is this table on the nullable side of an outer join
 }
 
 type Column struct {
-	Name     string
-	DataType string
-	NotNull  bool
-	IsArray  bool
-	Comment  string
-	Length   *int
+	Name         string
+	OriginalName string
+	DataType     string
+	NotNull      bool
+	IsArray      bool
+	Comment      string
+	Length       *int
+
+	IsSqlcSlice bool           // is this sqlc.slice()
+	EmbedTable  *ast.TableName // is this sqlc.embed(table)
+
+	// A string literal, whose type PostgreSQL infers from the context it's
+	// used in
+	untyped bool
 
 	// XXX: Figure out what PostgreSQL calls `foo.id`
 	Scope string
@@ -38,6 +43,19 @@ type Query struct {
 
 	// XXX: Hack
 	Filename string
+
+	// Needed for CopyFrom
+	InsertIntoTable *ast.TableName
+
+	// The parameter numbers of the ? placeholders of the query, in order.
+	// Only set if a placeholder is bound to the same parameter as another one.
+	Placeholders []int
+
+	// Types given by the @param and @column annotations of the query, keyed
+	// by parameter number and column position. They're written in the
+	// language of the generated code.
+	ParamTypes  map[int]string
+	ColumnTypes map[int]string
 }
 
 type Parameter struct {
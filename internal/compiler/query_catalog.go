@@ -1,20 +1,24 @@
 package compiler
 
 import (
-	"fmt"
-
+	"github.com/kyleconroy/sqlc/internal/config"
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/rewrite"
 )
 
 type QueryCatalog struct {
 	catalog *catalog.Catalog
 	ctes    map[string]*Table
+	embeds  rewrite.EmbedSet
+	engine  config.Engine
 }
 
-func buildQueryCatalog(c *catalog.Catalog, node ast.Node) (*QueryCatalog, error) {
+func buildQueryCatalog(c *catalog.Catalog, engine config.Engine, node ast.Node, embeds rewrite.EmbedSet) (*QueryCatalog, error) {
 	var with *ast.WithClause
 	switch n := node.(type) {
+	case *ast.DeleteStmt:
+		with = n.WithClause
 	case *ast.InsertStmt:
 		with = n.WithClause
 	case *ast.UpdateStmt:
@@ -24,22 +28,25 @@ func buildQueryCatalog(c *catalog.Catalog, node ast.Node) (*QueryCatalog, error)
 	default:
 		with = nil
 	}
-	qc := &QueryCatalog{catalog: c, ctes: map[string]*Table{}}
+	qc := &QueryCatalog{catalog: c, ctes: map[string]*Table{}, embeds: embeds, engine: engine}
 	if with != nil {
 		for _, item := range with.Ctes.Items {
 			if cte, ok := item.(*ast.CommonTableExpr); ok {
-				cols, err := outputColumns(qc, cte.Ctequery)
+				// The recursive term of a recursive query refers to the
+				// query itself, so the non-recursive term defines the
+				// columns that it sees
+				if sel, ok := cte.Ctequery.(*ast.SelectStmt); ok && with.Recursive && sel.Op != ast.None && sel.Larg != nil {
+					table, err := cteTable(qc, cte, sel.Larg)
+					if err != nil {
+						return nil, err
+					}
+					qc.ctes[*cte.Ctename] = table
+				}
+				table, err := cteTable(qc, cte, cte.Ctequery)
 				if err != nil {
 					return nil, err
 				}
-				rel := &ast.TableName{Name: *cte.Ctename}
-				for i := range cols {
-					cols[i].Table = rel
-				}
-				qc.ctes[*cte.Ctename] = &Table{
-					Rel:     rel,
-					Columns: cols,
-				}
+				qc.ctes[*cte.Ctename] = table
 			}
 		}
 	}
@@ -61,12 +68,17 @@ func ConvertColumn(rel *ast.TableName, c *catalog.Column) *Column {
 func (qc QueryCatalog) GetTable(rel *ast.TableName) (*Table, error) {
 	cte, exists := qc.ctes[rel.Name]
 	if exists {
-		return cte, nil
+		// Callers rename aliased tables, so don't hand out the shared copy
+		return &Table{Rel: cte.Rel, Columns: cte.Columns}, nil
 	}
 	src, err := qc.catalog.GetTable(rel)
 	if err != nil {
 		return nil, err
 	}
+	// Tables found on the search path are known by their qualified name
+	if rel.Schema == "" && src.Rel.Schema != "" && src.Rel.Schema != qc.catalog.DefaultSchema {
+		rel = &ast.TableName{Catalog: rel.Catalog, Schema: src.Rel.Schema, Name: rel.Name}
+	}
 	var cols []*Column
 	for _, c := range src.Columns {
 		cols = append(cols, ConvertColumn(rel, c))
@@ -74,16 +86,29 @@ func (qc QueryCatalog) GetTable(rel *ast.TableName) (*Table, error) {
 	return &Table{Rel: rel, Columns: cols}, nil
 }
 
-func (qc QueryCatalog) GetFunc(rel *ast.FuncName) (*Function, error) {
-	funcs, err := qc.catalog.ListFuncsByName(rel)
+// cteTable computes the columns of a common table expression from a query.
+// An explicit column list renames them.
+func cteTable(qc *QueryCatalog, cte *ast.CommonTableExpr, query ast.Node) (*Table, error) {
+	cols, err := outputColumns(qc, query)
 	if err != nil {
 		return nil, err
 	}
-	if len(funcs) == 0 {
-		return nil, fmt.Errorf("function not found: %s", rel.Name)
+	if cte.Aliascolnames != nil {
+		for i, item := range cte.Aliascolnames.Items {
+			if i >= len(cols) {
+				break
+			}
+			if name, ok := item.(*ast.String); ok {
+				cols[i].Name = name.Str
+			}
+		}
+	}
+	rel := &ast.TableName{Name: *cte.Ctename}
+	for i := range cols {
+		cols[i].Table = rel
 	}
-	return &Function{
-		Rel:        rel,
-		ReturnType: funcs[0].ReturnType,
+	return &Table{
+		Rel:     rel,
+		Columns: cols,
 	}, nil
 }
@@ -0,0 +1,150 @@
+package compiler
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// rangeFunctionTable describes the rows produced by the functions in a FROM
+// clause item. Earlier items in the FROM clause are passed as tables, so that
+// LATERAL references can be typed. It returns nil if a function is unknown;
+// many queries depend on functions that sqlc doesn't know about.
+func rangeFunctionTable(qc *QueryCatalog, tables []*Table, n *ast.RangeFunction) *Table {
+	var name string
+	var cols []*Column
+	scalar := false
+	for _, item := range n.Functions.Items {
+		// Each item holds the function call and, for ROWS FROM, its column
+		// definition list
+		list, ok := item.(*ast.List)
+		if !ok || len(list.Items) == 0 {
+			continue
+		}
+		call, ok := list.Items[0].(*ast.FuncCall)
+		if !ok {
+			return nil
+		}
+		coldefs := n.Coldeflist
+		if len(list.Items) > 1 {
+			if l, ok := list.Items[1].(*ast.List); ok && len(l.Items) > 0 {
+				coldefs = l
+			}
+		}
+		fcols, isScalar := funcColumns(qc, tables, call, coldefs)
+		if fcols == nil {
+			return nil
+		}
+		if name == "" {
+			name = call.Func.Name
+		}
+		scalar = isScalar && len(n.Functions.Items) == 1
+		cols = append(cols, fcols...)
+	}
+	if name == "" {
+		return nil
+	}
+
+	if n.Alias != nil && n.Alias.Aliasname != nil {
+		name = *n.Alias.Aliasname
+		// A function returning a base type produces a single column, named
+		// after the alias if no column aliases are given
+		if scalar && (n.Alias.Colnames == nil || len(n.Alias.Colnames.Items) == 0) {
+			cols[0].Name = name
+		}
+	}
+	if n.Ordinality {
+		cols = append(cols, &Column{
+			Name:     "ordinality",
+			DataType: "bigint",
+			NotNull:  true,
+		})
+	}
+	if n.Alias != nil && n.Alias.Colnames != nil {
+		for i, colname := range stringSlice(n.Alias.Colnames) {
+			if i < len(cols) {
+				cols[i].Name = colname
+			}
+		}
+	}
+	rel := &ast.TableName{Name: name}
+	for _, col := range cols {
+		if col.Table == nil {
+			col.Table = rel
+		}
+	}
+	return &Table{Rel: rel, Columns: cols}
+}
+
+// funcColumns returns the columns of the rows returned by a function call,
+// and whether the function returns a base type rather than rows
+func funcColumns(qc *QueryCatalog, tables []*Table, call *ast.FuncCall, coldefs *ast.List) ([]*Column, bool) {
+	fun, err := qc.catalog.ResolveFuncCall(call)
+	if err != nil {
+		return nil, false
+	}
+
+	// Functions returning record need a column definition list
+	if coldefs != nil && len(coldefs.Items) > 0 {
+		var cols []*Column
+		for _, item := range coldefs.Items {
+			def, ok := item.(*ast.ColumnDef)
+			if !ok || def.TypeName == nil {
+				continue
+			}
+			col := qualifiedColumn(qc.catalog, def.TypeName)
+			col.Name = def.Colname
+			col.NotNull = false
+			cols = append(cols, col)
+		}
+		return cols, false
+	}
+
+	if out := fun.OutArgs(); len(out) > 0 {
+		var cols []*Column
+		for _, arg := range out {
+			cols = append(cols, argColumn(arg))
+		}
+		return cols, false
+	}
+
+	if fun.ReturnType == nil {
+		return nil, false
+	}
+	rt := fun.ReturnType
+	if table, err := qc.GetTable(&ast.TableName{Catalog: rt.Catalog, Schema: rt.Schema, Name: rt.Name}); err == nil {
+		var cols []*Column
+		for _, c := range table.Columns {
+			col := *c
+			cols = append(cols, &col)
+		}
+		return cols, false
+	}
+	if ct, err := qc.catalog.GetCompositeType(rt); err == nil {
+		var cols []*Column
+		for _, c := range ct.Columns {
+			cols = append(cols, ConvertColumn(nil, c))
+		}
+		return cols, false
+	}
+
+	t := &exprTyper{qc: qc, tables: tables, res: &ast.ResTarget{}}
+	col := t.funcCall(call)
+	if col.DataType == "any" || col.DataType == "record" || col.DataType == "pg_catalog.record" {
+		return nil, false
+	}
+	// The elements of an array, as returned by unnest, may be NULL
+	if isPolymorphic(normalizeType(dataType(rt))) {
+		col.NotNull = false
+	}
+	col.Name = call.Func.Name
+	return []*Column{col}, true
+}
+
+func argColumn(arg *catalog.Argument) *Column {
+	return &Column{
+		Name:     arg.Name,
+		DataType: dataType(arg.Type),
+		IsArray:  isArray(arg.Type),
+		Type:     arg.Type,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/astutils"
 	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/named"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 )
 
@@ -18,29 +19,39 @@ func dataType(n *ast.TypeName) string {
 	}
 }
 
-func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef, names map[int]string) ([]Parameter, error) {
+func resolveCatalogRefs(c *catalog.Catalog, qc *QueryCatalog, rvs []*ast.RangeVar, args []paramRef, names map[int]named.Param) ([]Parameter, error) {
 	aliasMap := map[string]*ast.TableName{}
 	// TODO: Deprecate defaultTable
 	var defaultTable *ast.TableName
 	var tables []*ast.TableName
 
 	parameterName := func(n int, defaultName string) string {
-		if n, ok := names[n]; ok {
-			return n
+		if p, ok := names[n]; ok {
+			return p.Name
 		}
 		return defaultName
 	}
 
+	// relSchema returns the schema of the table that a name refers to
+	relSchema := func(rel *ast.TableName) string {
+		if rel.Schema != "" {
+			return rel.Schema
+		}
+		if _, ok := qc.ctes[rel.Name]; !ok {
+			if table, err := c.GetTable(rel); err == nil && table.Rel.Schema != "" {
+				return table.Rel.Schema
+			}
+		}
+		return c.DefaultSchema
+	}
+
 	typeMap := map[string]map[string]map[string]*catalog.Column{}
 	indexTable := func(table catalog.Table) error {
 		tables = append(tables, table.Rel)
 		if defaultTable == nil {
 			defaultTable = table.Rel
 		}
-		schema := table.Rel.Schema
-		if schema == "" {
-			schema = c.DefaultSchema
-		}
+		schema := relSchema(table.Rel)
 		if _, exists := typeMap[schema]; !exists {
 			typeMap[schema] = map[string]map[string]*catalog.Column{}
 		}
@@ -61,6 +72,13 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			return nil, err
 		}
 		table, err := c.GetTable(fqn)
+		if cte, ok := qc.ctes[fqn.Name]; ok && fqn.Schema == "" {
+			// Common table expressions shadow tables of the same name
+			table, err = catalog.Table{Rel: fqn}, nil
+			for _, col := range cte.Columns {
+				table.Columns = append(table.Columns, catalogColumn(col))
+			}
+		}
 		if err != nil {
 			continue
 		}
@@ -73,6 +91,46 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 		}
 	}
 
+	// scopeTables returns the tables, visible from the given scope, that have a
+	// column with the given name. The innermost scope with a match wins.
+	scopeTables := func(scope *paramScope, alias, key string) []*ast.TableName {
+		for s := scope; s != nil; s = s.parent {
+			if alias == "excluded" && s.excluded != nil {
+				if fqn, err := ParseTableName(s.excluded); err == nil {
+					if _, ok := typeMap[relSchema(fqn)][fqn.Name][key]; ok {
+						return []*ast.TableName{fqn}
+					}
+				}
+			}
+			var found []*ast.TableName
+			for _, rv := range s.rvs {
+				if rv.Relname == nil {
+					continue
+				}
+				fqn, err := ParseTableName(rv)
+				if err != nil {
+					continue
+				}
+				if alias != "" {
+					name := fqn.Name
+					if rv.Alias != nil {
+						name = *rv.Alias.Aliasname
+					}
+					if name != alias {
+						continue
+					}
+				}
+				if _, ok := typeMap[relSchema(fqn)][fqn.Name][key]; ok {
+					found = append(found, fqn)
+				}
+			}
+			if len(found) > 0 {
+				return found
+			}
+		}
+		return nil
+	}
+
 	var a []Parameter
 	for _, ref := range args {
 		switch n := ref.parent.(type) {
@@ -87,6 +145,18 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				},
 			})
 
+		case *setOpTarget:
+			cols, err := outputColumns(qc, n.stmt)
+			if err != nil {
+				return nil, err
+			}
+			col := typeOf(cols[n.index])
+			col.Name = parameterName(ref.ref.Number, cols[n.index].Name)
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: col,
+			})
+
 		case *limitCount:
 			a = append(a, Parameter{
 				Number: ref.ref.Number,
@@ -136,7 +206,9 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				}
 
 				search := tables
-				if alias != "" {
+				if found := scopeTables(ref.scope, alias, key); len(found) > 0 {
+					search = found
+				} else if alias != "" {
 					if original, ok := aliasMap[alias]; ok {
 						search = []*ast.TableName{original}
 					} else {
@@ -148,13 +220,12 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 					}
 				}
 
+				// The parameter of col = ANY($1) is an array of values
+				isArray := n.Rexpr == ast.Node(ref.ref) && (n.Kind == ast.AEXPR_OP_ANY || n.Kind == ast.AEXPR_OP_ALL)
+
 				var found int
 				for _, table := range search {
-					schema := table.Schema
-					if schema == "" {
-						schema = c.DefaultSchema
-					}
-					if c, ok := typeMap[schema][table.Name][key]; ok {
+					if c, ok := typeMap[relSchema(table)][table.Name][key]; ok {
 						found += 1
 						if ref.name != "" {
 							key = ref.name
@@ -165,7 +236,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 								Name:     parameterName(ref.ref.Number, key),
 								DataType: dataType(&c.Type),
 								NotNull:  c.IsNotNull,
-								IsArray:  c.IsArray,
+								IsArray:  c.IsArray || isArray,
 								Length:   c.Length,
 								Table:    table,
 							},
@@ -255,8 +326,12 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				var paramName string
 				var paramType *ast.TypeName
 				if argName == "" {
-					paramName = fun.Args[i].Name
-					paramType = fun.Args[i].Type
+					inArgs := fun.InArgs()
+					if i >= len(inArgs) {
+						continue
+					}
+					paramName = inArgs[i].Name
+					paramType = inArgs[i].Type
 				} else {
 					paramName = argName
 					for _, arg := range fun.Args {
@@ -278,6 +353,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 						Name:     parameterName(ref.ref.Number, paramName),
 						DataType: dataType(paramType),
 						NotNull:  true,
+						IsArray:  isArray(paramType),
 					},
 				})
 			}
@@ -301,7 +377,8 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			}
 		case *ast.ResTarget:
 			if n.Name == nil {
-				return nil, fmt.Errorf("*ast.ResTarget has nil name")
+				// Parameters in a target list are typed by inferParameters
+				continue
 			}
 			key := *n.Name
 
@@ -316,19 +393,18 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				schema = fqn.Schema
 				rel = fqn.Name
 			}
-			if schema == "" {
-				schema = c.DefaultSchema
-			}
+			schema = relSchema(&ast.TableName{Schema: schema, Name: rel})
 			if c, ok := typeMap[schema][rel][key]; ok {
 				a = append(a, Parameter{
 					Number: ref.ref.Number,
 					Column: &Column{
-						Name:     parameterName(ref.ref.Number, key),
-						DataType: dataType(&c.Type),
-						NotNull:  c.IsNotNull,
-						IsArray:  c.IsArray,
-						Table:    &ast.TableName{Schema: schema, Name: rel},
-						Length:   c.Length,
+						Name:         parameterName(ref.ref.Number, key),
+						OriginalName: key,
+						DataType:     dataType(&c.Type),
+						NotNull:      c.IsNotNull,
+						IsArray:      c.IsArray,
+						Table:        &ast.TableName{Schema: schema, Name: rel},
+						Length:       c.Length,
 					},
 				})
 			} else {
@@ -343,7 +419,7 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			if n.TypeName == nil {
 				return nil, fmt.Errorf("*ast.TypeCast has nil type name")
 			}
-			col := toColumn(n.TypeName)
+			col := qualifiedColumn(c, n.TypeName)
 			col.Name = parameterName(ref.ref.Number, col.Name)
 			a = append(a, Parameter{
 				Number: ref.ref.Number,
@@ -352,9 +428,22 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 
 		case *ast.ParamRef:
 			a = append(a, Parameter{Number: ref.ref.Number})
-
-		default:
-			fmt.Printf("unsupported reference type: %T", n)
+		}
+	}
+	a, err := inferParameters(qc, args, a, names)
+	if err != nil {
+		return nil, err
+	}
+	for i := range a {
+		p, ok := names[a[i].Number]
+		if !ok || a[i].Column == nil {
+			continue
+		}
+		if p.Nullable {
+			a[i].Column.NotNull = false
+		}
+		if p.IsSqlcSlice {
+			a[i].Column.IsSqlcSlice = true
 		}
 	}
 	return a, nil
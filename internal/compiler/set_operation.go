@@ -0,0 +1,144 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/config"
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// setOpColumns computes the output columns of a UNION, INTERSECT or EXCEPT.
+// The columns are named after the left arm, while their types are resolved
+// across both arms.
+func setOpColumns(qc *QueryCatalog, n *ast.SelectStmt) ([]*Column, error) {
+	left, err := outputColumns(qc, n.Larg)
+	if err != nil {
+		return nil, err
+	}
+	right, err := outputColumns(qc, n.Rarg)
+	if err != nil {
+		return nil, err
+	}
+	if len(left) != len(right) {
+		return nil, &sqlerr.Error{
+			Code:     "42601",
+			Message:  fmt.Sprintf("each %s query must have the same number of columns", strings.ToUpper(n.Op.String())),
+			Location: targetLocation(n.Rarg, 0),
+		}
+	}
+	cols := make([]*Column, 0, len(left))
+	for i := range left {
+		col := *left[i]
+		typ, ok := commonType(qc.engine, left[i], right[i])
+		if !ok {
+			return nil, &sqlerr.Error{
+				Code:     "42804",
+				Message:  fmt.Sprintf("%s types %s and %s cannot be matched", strings.ToUpper(n.Op.String()), normalizeType(left[i].DataType), normalizeType(right[i].DataType)),
+				Location: targetLocation(n.Rarg, i),
+			}
+		}
+		col.DataType = typ.DataType
+		col.Type = typ.Type
+		col.IsArray = typ.IsArray
+		col.Length = typ.Length
+		col.untyped = false
+		switch n.Op {
+		case ast.Union:
+			col.NotNull = left[i].NotNull && right[i].NotNull
+		case ast.Intersect:
+			// A row has to be in both arms, and NULL only matches NULL
+			col.NotNull = left[i].NotNull || right[i].NotNull
+		}
+		// EXCEPT only returns rows of the left arm, which keep their
+		// nullability
+		cols = append(cols, &col)
+	}
+	return cols, nil
+}
+
+// commonType resolves the types of a column of two set operation arms into
+// the type of the result. Untyped expressions, such as NULL and parameters,
+// take the type of the other arm, and numbers are promoted to the wider type.
+// PostgreSQL infers the type of a string literal from the other arm as well,
+// but rejects arms whose types can't be matched. MySQL falls back to a string.
+func commonType(engine config.Engine, l, r *Column) (*Column, bool) {
+	lt, rt := normalizeType(l.DataType), normalizeType(r.DataType)
+	switch {
+	case rt == "any":
+		return typeOf(l), true
+	case lt == "any":
+		return typeOf(r), true
+	case engine == config.EnginePostgreSQL && r.untyped:
+		return typeOf(l), true
+	case engine == config.EnginePostgreSQL && l.untyped:
+		return typeOf(r), true
+	case l.IsArray != r.IsArray:
+		return typeOf(l), true
+	case lt == rt:
+		col := typeOf(l)
+		if l.Length == nil || r.Length == nil || *l.Length != *r.Length {
+			col.Length = nil
+		}
+		return col, true
+	case numericRank(lt) > 0 && numericRank(rt) > 0:
+		if numericRank(rt) > numericRank(lt) {
+			return typeOf(r), true
+		}
+		return typeOf(l), true
+	case isText(lt) && isText(rt):
+		return &Column{DataType: "text", IsArray: l.IsArray}, true
+	case lt == "date" && isTimestamp(rt):
+		return typeOf(r), true
+	case isTimestamp(lt) && isTimestamp(rt):
+		return &Column{DataType: "timestamptz", IsArray: l.IsArray}, true
+	}
+	switch engine {
+	case config.EngineMySQL:
+		// String literals are VARCHAR, while a TEXT column stays TEXT
+		if (lt == "text" && !l.untyped) || (rt == "text" && !r.untyped) {
+			return &Column{DataType: "text"}, true
+		}
+		return &Column{DataType: "varchar"}, true
+	case config.EnginePostgreSQL:
+		lc, rc := typeCategory(lt), typeCategory(rt)
+		if lc != "" && rc != "" && lc != rc {
+			return nil, false
+		}
+	}
+	return typeOf(l), true
+}
+
+// typeCategory returns the PostgreSQL category of a normalized type, or an
+// empty string if it isn't known
+func typeCategory(name string) string {
+	switch {
+	case name == "boolean":
+		return "B"
+	case isDateTime(name), name == "time without time zone", name == "time with time zone", name == "interval":
+		return "D"
+	case isNetwork(name):
+		return "I"
+	case numericRank(name) > 0:
+		return "N"
+	case isText(name):
+		return "S"
+	}
+	return ""
+}
+
+// targetLocation returns the location of the i-th target of a query, which
+// is where mismatched set operation arms are reported
+func targetLocation(n *ast.SelectStmt, i int) int {
+	for n.Op != ast.None && n.Larg != nil {
+		n = n.Larg
+	}
+	if n.TargetList == nil || len(n.TargetList.Items) <= i {
+		return 0
+	}
+	if res, ok := n.TargetList.Items[i].(*ast.ResTarget); ok {
+		return res.Location
+	}
+	return 0
+}
@@ -5,10 +5,11 @@ import (
 
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 )
 
 func isArray(n *ast.TypeName) bool {
-	if n == nil {
+	if n == nil || n.ArrayBounds == nil {
 		return false
 	}
 	return len(n.ArrayBounds.Items) > 0
@@ -29,3 +30,14 @@ func toColumn(n *ast.TypeName) *Column {
 		IsArray:  isArray(n),
 	}
 }
+
+// qualifiedColumn builds a column for a type that's named in a query. Types
+// found on the search path outside of the default schema are qualified.
+func qualifiedColumn(c *catalog.Catalog, n *ast.TypeName) *Column {
+	col := toColumn(n)
+	if typ := c.QualifyType(col.Type); typ != col.Type {
+		col.Type = typ
+		col.DataType = typ.Schema + "." + typ.Name
+	}
+	return col
+}
@@ -76,6 +76,11 @@ const (
 	EngineXLemon Engine = "_lemon"
 )
 
+const (
+	SQLPackageStandard = "database/sql"
+	SQLPackagePGX      = "pgx/v4"
+)
+
 type Config struct {
 	Version string `json:"version" yaml:"version"`
 	SQL     []SQL  `json:"sql" yaml:"sql"`
@@ -97,10 +102,11 @@ type GenKotlin struct {
 }
 
 type SQL struct {
-	Engine  Engine `json:"engine,omitempty" yaml:"engine"`
-	Schema  Paths  `json:"schema" yaml:"schema"`
-	Queries Paths  `json:"queries" yaml:"queries"`
-	Gen     SQLGen `json:"gen" yaml:"gen"`
+	Engine     Engine   `json:"engine,omitempty" yaml:"engine"`
+	Schema     Paths    `json:"schema" yaml:"schema"`
+	Queries    Paths    `json:"queries" yaml:"queries"`
+	SearchPath []string `json:"search_path,omitempty" yaml:"search_path"`
+	Gen        SQLGen   `json:"gen" yaml:"gen"`
 }
 
 type SQLGen struct {
@@ -116,6 +122,8 @@ type SQLGo struct {
 	EmitPreparedQueries   bool              `json:"emit_prepared_queries" yaml:"emit_prepared_queries"`
 	EmitExactTableNames   bool              `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
 	EmitEmptySlices       bool              `json:"emit_empty_slices,omitempty" yaml:"emit_empty_slices"`
+	EmitFunctions         bool              `json:"emit_functions,omitempty" yaml:"emit_functions"`
+	OmitPartitionModels   bool              `json:"omit_partition_models,omitempty" yaml:"omit_partition_models"`
 	JSONTagsCaseStyle     string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	Package               string            `json:"package" yaml:"package"`
 	Out                   string            `json:"out" yaml:"out"`
@@ -124,11 +132,15 @@ type SQLGo struct {
 	OutputDBFileName      string            `json:"output_db_file_name,omitempty" yaml:"output_db_file_name"`
 	OutputModelsFileName  string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
 	OutputQuerierFileName string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
+	OutputBatchFileName   string            `json:"output_batch_file_name,omitempty" yaml:"output_batch_file_name"`
 	OutputFilesSuffix     string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
+	SQLPackage            string            `json:"sql_package,omitempty" yaml:"sql_package"`
 }
 
 type SQLKotlin struct {
 	EmitExactTableNames bool   `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
+	EmitFunctions       bool   `json:"emit_functions,omitempty" yaml:"emit_functions"`
+	OmitPartitionModels bool   `json:"omit_partition_models,omitempty" yaml:"omit_partition_models"`
 	Package             string `json:"package" yaml:"package"`
 	Out                 string `json:"out" yaml:"out"`
 }
@@ -137,6 +149,8 @@ type SQLPython struct {
 	EmitExactTableNames bool       `json:"emit_exact_table_names" yaml:"emit_exact_table_names"`
 	EmitSyncQuerier     bool       `json:"emit_sync_querier" yaml:"emit_sync_querier"`
 	EmitAsyncQuerier    bool       `json:"emit_async_querier" yaml:"emit_async_querier"`
+	EmitFunctions       bool       `json:"emit_functions,omitempty" yaml:"emit_functions"`
+	OmitPartitionModels bool       `json:"omit_partition_models,omitempty" yaml:"omit_partition_models"`
 	Package             string     `json:"package" yaml:"package"`
 	Out                 string     `json:"out" yaml:"out"`
 	Overrides           []Override `json:"overrides,omitempty" yaml:"overrides"`
@@ -237,6 +251,22 @@ var ErrNoPackageName = errors.New("missing package name")
 var ErrNoPackagePath = errors.New("missing package path")
 var ErrNoOutPath = errors.New("no output path")
 var ErrNoQuerierType = errors.New("no querier emit type enabled")
+var ErrUnknownSQLPackage = errors.New("invalid sql package")
+var ErrSQLPackageEngine = errors.New("sql package requires the postgresql engine")
+
+func validateSQLPackage(engine Engine, pkg string) error {
+	switch pkg {
+	case "", SQLPackageStandard:
+		return nil
+	case SQLPackagePGX:
+		if engine != EnginePostgreSQL {
+			return ErrSQLPackageEngine
+		}
+		return nil
+	default:
+		return ErrUnknownSQLPackage
+	}
+}
 
 func ParseConfig(rd io.Reader) (Config, error) {
 	var buf bytes.Buffer
@@ -23,6 +23,16 @@ const unknownFields = `{
   "foo": "bar"
 }`
 
+const unknownSQLPackage = `{
+  "version": "1",
+  "packages": [{"path": "db", "sql_package": "pgx/v3"}]
+}`
+
+const pgxWithMySQL = `{
+  "version": "1",
+  "packages": [{"path": "db", "engine": "mysql", "sql_package": "pgx/v4"}]
+}`
+
 func TestBadConfigs(t *testing.T) {
 	for _, test := range []struct {
 		name string
@@ -50,6 +60,16 @@ func TestBadConfigs(t *testing.T) {
   line 3: field foo not found in type config.V1GenerateSettings`,
 			unknownFields,
 		},
+		{
+			"unknown sql package",
+			"invalid sql package",
+			unknownSQLPackage,
+		},
+		{
+			"pgx with mysql",
+			"sql package requires the postgresql engine",
+			pgxWithMySQL,
+		},
 	} {
 		tt := test
 		t.Run(tt.name, func(t *testing.T) {
@@ -91,6 +111,15 @@ func TestTypeOverrides(t *testing.T) {
 		// 	"*ksuid.KSUID",
 		// 	false,
 		// },
+		{
+			Override{
+				DBType: "timestamptz",
+				GoType: GoType{Spec: "time.Time"},
+			},
+			"time",
+			"time.Time",
+			false,
+		},
 		{
 			Override{
 				DBType: "citext",
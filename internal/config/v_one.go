@@ -21,18 +21,23 @@ type v1PackageSettings struct {
 	Path                  string     `json:"path" yaml:"path"`
 	Schema                Paths      `json:"schema" yaml:"schema"`
 	Queries               Paths      `json:"queries" yaml:"queries"`
+	SearchPath            []string   `json:"search_path,omitempty" yaml:"search_path"`
 	EmitInterface         bool       `json:"emit_interface" yaml:"emit_interface"`
 	EmitJSONTags          bool       `json:"emit_json_tags" yaml:"emit_json_tags"`
 	EmitDBTags            bool       `json:"emit_db_tags" yaml:"emit_db_tags"`
 	EmitPreparedQueries   bool       `json:"emit_prepared_queries" yaml:"emit_prepared_queries"`
 	EmitExactTableNames   bool       `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
 	EmitEmptySlices       bool       `json:"emit_empty_slices,omitempty" yaml:"emit_empty_slices"`
+	EmitFunctions         bool       `json:"emit_functions,omitempty" yaml:"emit_functions"`
+	OmitPartitionModels   bool       `json:"omit_partition_models,omitempty" yaml:"omit_partition_models"`
 	JSONTagsCaseStyle     string     `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	Overrides             []Override `json:"overrides" yaml:"overrides"`
 	OutputDBFileName      string     `json:"output_db_file_name,omitempty" yaml:"output_db_file_name"`
 	OutputModelsFileName  string     `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
 	OutputQuerierFileName string     `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
+	OutputBatchFileName   string     `json:"output_batch_file_name,omitempty" yaml:"output_batch_file_name"`
 	OutputFilesSuffix     string     `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
+	SQLPackage            string     `json:"sql_package,omitempty" yaml:"sql_package"`
 }
 
 func v1ParseConfig(rd io.Reader) (Config, error) {
@@ -75,6 +80,9 @@ func v1ParseConfig(rd io.Reader) (Config, error) {
 		if settings.Packages[j].Engine == "" {
 			settings.Packages[j].Engine = EnginePostgreSQL
 		}
+		if err := validateSQLPackage(settings.Packages[j].Engine, settings.Packages[j].SQLPackage); err != nil {
+			return config, err
+		}
 	}
 	return settings.Translate(), nil
 }
@@ -103,9 +111,10 @@ func (c *V1GenerateSettings) Translate() Config {
 
 	for _, pkg := range c.Packages {
 		conf.SQL = append(conf.SQL, SQL{
-			Engine:  pkg.Engine,
-			Schema:  pkg.Schema,
-			Queries: pkg.Queries,
+			Engine:     pkg.Engine,
+			Schema:     pkg.Schema,
+			Queries:    pkg.Queries,
+			SearchPath: pkg.SearchPath,
 			Gen: SQLGen{
 				Go: &SQLGo{
 					EmitInterface:         pkg.EmitInterface,
@@ -114,6 +123,8 @@ func (c *V1GenerateSettings) Translate() Config {
 					EmitPreparedQueries:   pkg.EmitPreparedQueries,
 					EmitExactTableNames:   pkg.EmitExactTableNames,
 					EmitEmptySlices:       pkg.EmitEmptySlices,
+					EmitFunctions:         pkg.EmitFunctions,
+					OmitPartitionModels:   pkg.OmitPartitionModels,
 					Package:               pkg.Name,
 					Out:                   pkg.Path,
 					Overrides:             pkg.Overrides,
@@ -121,7 +132,9 @@ func (c *V1GenerateSettings) Translate() Config {
 					OutputDBFileName:      pkg.OutputDBFileName,
 					OutputModelsFileName:  pkg.OutputModelsFileName,
 					OutputQuerierFileName: pkg.OutputQuerierFileName,
+					OutputBatchFileName:   pkg.OutputBatchFileName,
 					OutputFilesSuffix:     pkg.OutputFilesSuffix,
+					SQLPackage:            pkg.SQLPackage,
 				},
 			},
 		})
@@ -45,6 +45,9 @@ func v2ParseConfig(rd io.Reader) (Config, error) {
 			if conf.SQL[j].Gen.Go.Package == "" {
 				conf.SQL[j].Gen.Go.Package = filepath.Base(conf.SQL[j].Gen.Go.Out)
 			}
+			if err := validateSQLPackage(conf.SQL[j].Engine, conf.SQL[j].Gen.Go.SQLPackage); err != nil {
+				return conf, err
+			}
 			for i := range conf.SQL[j].Gen.Go.Overrides {
 				if err := conf.SQL[j].Gen.Go.Overrides[i].Parse(); err != nil {
 					return conf, err
@@ -0,0 +1,27 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: DeleteBook :batchexec
+DELETE FROM books
+WHERE book_id = $1;
+
+-- name: BooksByYear :batchmany
+SELECT * FROM books
+WHERE year = $1;
+
+-- name: CreateBook :batchone
+INSERT INTO books (
+  author_id, title, year
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: UpdateBookTitle :batchexec
+UPDATE books SET title = $1
+WHERE book_id = $2;
+
+-- name: GetBookTitles :batchmany
+SELECT book_id, title FROM books
+WHERE author_id = $1;
@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL,
+  bio  text
+);
+
+CREATE TABLE books (
+  book_id   SERIAL PRIMARY KEY,
+  author_id BIGINT NOT NULL REFERENCES authors(id),
+  title     text   NOT NULL,
+  year      INT    NOT NULL DEFAULT 2000
+);
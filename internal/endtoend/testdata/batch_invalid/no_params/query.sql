@@ -0,0 +1,4 @@
+CREATE TABLE foo (bar text not null, baz int not null);
+
+-- name: DeleteFoo :batchexec
+DELETE FROM foo;
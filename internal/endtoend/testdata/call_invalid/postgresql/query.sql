@@ -0,0 +1,5 @@
+-- name: AddNumbers :exec
+CALL add_numbers($1, $2);
+
+-- name: Missing :exec
+CALL missing_procedure($1);
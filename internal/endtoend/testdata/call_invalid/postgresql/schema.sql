@@ -0,0 +1,3 @@
+CREATE FUNCTION add_numbers(a INT, b INT) RETURNS INT AS $$
+    SELECT a + b
+$$ LANGUAGE sql;
@@ -0,0 +1,8 @@
+-- name: CreateOrder :exec
+INSERT INTO orders (price, quantity) VALUES (?, ?);
+
+-- name: ImportOrder :exec
+INSERT INTO orders (id, price, total) VALUES (?, ?, DEFAULT);
+
+-- name: UpdateQuantity :exec
+UPDATE orders SET quantity = ? WHERE id = ?;
@@ -0,0 +1,7 @@
+CREATE TABLE orders (
+  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  price int NOT NULL,
+  quantity int NOT NULL DEFAULT 1,
+  total int AS (price * quantity) STORED,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
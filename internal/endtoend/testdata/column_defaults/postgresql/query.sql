@@ -0,0 +1,11 @@
+-- name: CreateOrder :one
+INSERT INTO orders (price, quantity) VALUES ($1, $2) RETURNING *;
+
+-- name: CreateOrderWithDefaults :exec
+INSERT INTO orders (id, price, total) VALUES (DEFAULT, $1, DEFAULT);
+
+-- name: ImportOrder :exec
+INSERT INTO orders (id, seq, price) OVERRIDING SYSTEM VALUE VALUES ($1, $2, $3);
+
+-- name: UpdateQuantity :exec
+UPDATE orders SET quantity = $1, total = DEFAULT WHERE id = $2;
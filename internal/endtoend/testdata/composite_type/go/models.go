@@ -4,9 +4,271 @@ package querytest
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 )
 
+type FooPointType struct {
+	X sql.NullInt32
+	Y sql.NullInt32
+}
+
+func (t *FooPointType) Scan(src interface{}) error {
+	fields, err := parseCompositeRow(src)
+	if err != nil {
+		return fmt.Errorf("scan FooPointType: %w", err)
+	}
+	if len(fields) != 2 {
+		return fmt.Errorf("scan FooPointType: expected 2 attributes, got %d", len(fields))
+	}
+	if err := scanCompositeField(&t.X, fields[0]); err != nil {
+		return fmt.Errorf("scan FooPointType.X: %w", err)
+	}
+	if err := scanCompositeField(&t.Y, fields[1]); err != nil {
+		return fmt.Errorf("scan FooPointType.Y: %w", err)
+	}
+	return nil
+}
+
+func (t FooPointType) Value() (driver.Value, error) {
+	return formatCompositeRow(t.X, t.Y)
+}
+
+type NullFooPointType struct {
+	FooPointType FooPointType
+	Valid        bool // Valid is true if FooPointType is not NULL
+}
+
+func (n *NullFooPointType) Scan(src interface{}) error {
+	if src == nil {
+		n.FooPointType, n.Valid = FooPointType{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.FooPointType.Scan(src)
+}
+
+func (n NullFooPointType) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.FooPointType.Value()
+}
+
+type PointType struct {
+	X sql.NullInt32
+	Y sql.NullInt32
+}
+
+func (t *PointType) Scan(src interface{}) error {
+	fields, err := parseCompositeRow(src)
+	if err != nil {
+		return fmt.Errorf("scan PointType: %w", err)
+	}
+	if len(fields) != 2 {
+		return fmt.Errorf("scan PointType: expected 2 attributes, got %d", len(fields))
+	}
+	if err := scanCompositeField(&t.X, fields[0]); err != nil {
+		return fmt.Errorf("scan PointType.X: %w", err)
+	}
+	if err := scanCompositeField(&t.Y, fields[1]); err != nil {
+		return fmt.Errorf("scan PointType.Y: %w", err)
+	}
+	return nil
+}
+
+func (t PointType) Value() (driver.Value, error) {
+	return formatCompositeRow(t.X, t.Y)
+}
+
+type NullPointType struct {
+	PointType PointType
+	Valid     bool // Valid is true if PointType is not NULL
+}
+
+func (n *NullPointType) Scan(src interface{}) error {
+	if src == nil {
+		n.PointType, n.Valid = PointType{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.PointType.Scan(src)
+}
+
+func (n NullPointType) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.PointType.Value()
+}
+
+// parseCompositeRow splits a PostgreSQL row literal, such as (1,"a b",), into
+// its fields. NULL fields are returned as nil.
+func parseCompositeRow(src interface{}) ([]*string, error) {
+	var s string
+	switch v := src.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return nil, fmt.Errorf("unsupported scan type: %T", src)
+	}
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, fmt.Errorf("malformed row literal: %q", s)
+	}
+	s = s[1 : len(s)-1]
+
+	var fields []*string
+	var b strings.Builder
+	quoted, null := false, true
+	field := func() {
+		if null {
+			fields = append(fields, nil)
+		} else {
+			v := b.String()
+			fields = append(fields, &v)
+		}
+		b.Reset()
+		null = true
+	}
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case ch == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case ch == '"':
+			quoted = !quoted
+		case ch == ',' && !quoted:
+			field()
+			continue
+		default:
+			b.WriteByte(ch)
+		}
+		null = false
+	}
+	field()
+	return fields, nil
+}
+
+// scanCompositeField converts the text of a row literal field into dest
+func scanCompositeField(dest interface{}, src *string) error {
+	switch d := dest.(type) {
+	case *sql.NullTime:
+		if src == nil {
+			*d = sql.NullTime{}
+			return nil
+		}
+		t, err := parseCompositeTime(*src)
+		*d = sql.NullTime{Time: t, Valid: err == nil}
+		return err
+	case sql.Scanner:
+		if src == nil {
+			return d.Scan(nil)
+		}
+		return d.Scan(*src)
+	}
+	if src == nil {
+		return fmt.Errorf("cannot scan NULL into %T", dest)
+	}
+	var err error
+	switch d := dest.(type) {
+	case *string:
+		*d = *src
+	case *[]byte:
+		*d, err = hex.DecodeString(strings.TrimPrefix(*src, "\\x"))
+	case *bool:
+		*d = *src == "t"
+	case *int16:
+		var v int64
+		v, err = strconv.ParseInt(*src, 10, 16)
+		*d = int16(v)
+	case *int32:
+		var v int64
+		v, err = strconv.ParseInt(*src, 10, 32)
+		*d = int32(v)
+	case *int64:
+		*d, err = strconv.ParseInt(*src, 10, 64)
+	case *float32:
+		var v float64
+		v, err = strconv.ParseFloat(*src, 32)
+		*d = float32(v)
+	case *float64:
+		*d, err = strconv.ParseFloat(*src, 64)
+	case *time.Time:
+		*d, err = parseCompositeTime(*src)
+	default:
+		return fmt.Errorf("unsupported field type %T", dest)
+	}
+	return err
+}
+
+func parseCompositeTime(s string) (time.Time, error) {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05.999999999Z07:00:00",
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+		"15:04:05.999999999",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse %q as a time", s)
+}
+
+// formatCompositeRow encodes fields as a PostgreSQL row literal
+func formatCompositeRow(fields ...interface{}) (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('(')
+	for i, field := range fields {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if v, ok := field.(driver.Valuer); ok {
+			var err error
+			if field, err = v.Value(); err != nil {
+				return nil, err
+			}
+		}
+		var s string
+		switch v := field.(type) {
+		case nil:
+			continue
+		case string:
+			s = v
+		case []byte:
+			s = "\\x" + hex.EncodeToString(v)
+		case bool:
+			s = strconv.FormatBool(v)
+		case time.Time:
+			s = v.Format("2006-01-02 15:04:05.999999999Z07:00")
+		default:
+			s = fmt.Sprint(v)
+		}
+		b.WriteByte('"')
+		for j := 0; j < len(s); j++ {
+			if s[j] == '"' || s[j] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(s[j])
+		}
+		b.WriteByte('"')
+	}
+	b.WriteByte(')')
+	return b.String(), nil
+}
+
 type FooPath struct {
-	PointOne sql.NullString
-	PointTwo sql.NullString
+	PointOne NullPointType
+	PointTwo NullFooPointType
 }
@@ -0,0 +1,10 @@
+-- name: GetCustomer :one
+SELECT * FROM customers WHERE id = $1;
+
+-- name: CreateCustomer :one
+INSERT INTO customers (home, work, previous)
+VALUES ($1, $2, $3)
+RETURNING id;
+
+-- name: ListWorkAddresses :many
+SELECT work FROM customers;
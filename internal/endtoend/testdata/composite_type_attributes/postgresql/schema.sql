@@ -0,0 +1,16 @@
+CREATE TYPE address AS (
+    street text,
+    zip text,
+    country text
+);
+
+ALTER TYPE address ADD ATTRIBUTE verified_at timestamp;
+ALTER TYPE address DROP ATTRIBUTE country;
+ALTER TYPE address RENAME ATTRIBUTE zip TO postal_code;
+
+CREATE TABLE customers (
+    id serial PRIMARY KEY,
+    home address NOT NULL,
+    work address,
+    previous address[] NOT NULL
+);
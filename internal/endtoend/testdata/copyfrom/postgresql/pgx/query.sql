@@ -0,0 +1,8 @@
+-- name: InsertAuthors :copyfrom
+INSERT INTO authors (name, bio, tags) VALUES ($1, $2, $3);
+
+-- name: InsertAuthorsReordered :copyfrom
+INSERT INTO authors (bio, name) VALUES (sqlc.arg(bio), sqlc.arg(author_name));
+
+-- name: InsertEvents :copyfrom
+INSERT INTO audit.events (message) VALUES ($1);
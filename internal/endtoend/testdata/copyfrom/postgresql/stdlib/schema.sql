@@ -0,0 +1,13 @@
+CREATE SCHEMA audit;
+
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL,
+  bio  text,
+  tags text[]    NOT NULL
+);
+
+CREATE TABLE audit.events (
+  id      BIGSERIAL PRIMARY KEY,
+  message text NOT NULL
+);
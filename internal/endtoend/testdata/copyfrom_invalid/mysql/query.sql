@@ -0,0 +1,4 @@
+CREATE TABLE foo (bar text not null, baz int not null);
+
+-- name: InsertFoo :copyfrom
+INSERT INTO foo (bar, baz) VALUES (?, ?);
@@ -0,0 +1,16 @@
+CREATE TABLE foo (bar text not null, baz int not null);
+
+-- name: SelectFoo :copyfrom
+SELECT * FROM foo WHERE bar = $1;
+
+-- name: InsertReturning :copyfrom
+INSERT INTO foo (bar, baz) VALUES ($1, $2) RETURNING *;
+
+-- name: InsertLiteral :copyfrom
+INSERT INTO foo (bar, baz) VALUES ($1, 1);
+
+-- name: InsertDuplicate :copyfrom
+INSERT INTO foo (bar, baz) VALUES ($1, $1);
+
+-- name: InsertMultipleRows :copyfrom
+INSERT INTO foo (bar, baz) VALUES ($1, $2), ($3, $4);
@@ -5,5 +5,6 @@ package querytest
 import ()
 
 type Bar struct {
+	// Identity column
 	ID int64
 }
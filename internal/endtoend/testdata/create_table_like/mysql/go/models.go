@@ -7,6 +7,7 @@ import (
 )
 
 type SuperUser struct {
+	// Identity column
 	ID          int32
 	FirstName   string
 	LastName    sql.NullString
@@ -14,6 +15,7 @@ type SuperUser struct {
 }
 
 type User struct {
+	// Identity column
 	ID       int32
 	LastName sql.NullString
 	Age      int32
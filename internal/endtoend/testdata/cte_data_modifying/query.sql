@@ -0,0 +1,44 @@
+CREATE TABLE bar (id serial not null, ready bool not null, name text);
+CREATE TABLE archive (id integer not null, name text);
+
+-- name: Move :exec
+WITH moved AS (
+    DELETE FROM bar WHERE ready = $1 RETURNING id, name
+)
+INSERT INTO archive (id, name) SELECT id, name FROM moved;
+
+-- name: MoveReturning :many
+WITH moved AS (
+    DELETE FROM bar WHERE ready = $1 RETURNING *
+)
+INSERT INTO archive (id, name) SELECT id, name FROM moved RETURNING *;
+
+-- name: UpdateFromCTE :exec
+WITH ready_bars AS (
+    SELECT id FROM bar WHERE ready = $1
+)
+UPDATE bar SET name = $2 FROM ready_bars WHERE bar.id = ready_bars.id;
+
+-- name: DeleteWithCTE :many
+WITH old AS (
+    SELECT id FROM archive WHERE name = $1
+)
+DELETE FROM bar USING old WHERE bar.id = old.id RETURNING bar.id;
+
+-- name: DeleteWithCTESub :exec
+WITH old AS (
+    SELECT id FROM archive WHERE name = $1
+)
+DELETE FROM bar WHERE id IN (SELECT id FROM old);
+
+-- name: InsertSelectCTE :exec
+WITH src AS (
+    SELECT id, name FROM bar WHERE ready = $1
+)
+INSERT INTO archive (id, name) SELECT src.id, src.name FROM src;
+
+-- name: SelectCTEParams :many
+WITH filtered AS (
+    SELECT id, name FROM bar WHERE ready = $1
+)
+SELECT * FROM filtered WHERE filtered.name = $2;
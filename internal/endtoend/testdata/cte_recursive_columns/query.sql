@@ -0,0 +1,23 @@
+CREATE TABLE nodes (id integer NOT NULL, parent_id integer, name text NOT NULL);
+
+-- name: Recursive :many
+WITH RECURSIVE nums(n) AS (
+    SELECT 1
+    UNION ALL
+    SELECT n + 1 FROM nums WHERE n < $1
+)
+SELECT n FROM nums;
+
+-- name: Ancestors :many
+WITH RECURSIVE ancestors(node_id, node_name, depth) AS (
+    SELECT id, name, 0 FROM nodes WHERE id = $1
+    UNION ALL
+    SELECT n.parent_id, p.name, a.depth + 1
+    FROM ancestors AS a
+    JOIN nodes AS n ON n.id = a.node_id
+    JOIN nodes AS p ON p.id = n.parent_id
+)
+SELECT a.node_id, a.node_name, b.depth
+FROM ancestors AS a
+JOIN ancestors AS b ON b.node_id = a.node_id
+WHERE a.depth < $2;
@@ -0,0 +1,4 @@
+CREATE TABLE foo (bar text NOT NULL);
+ALTER TABLE foo ADD COLUMN IF NOT EXISTS bar int;
+ALTER TABLE foo ADD COLUMN IF NOT EXISTS baz bool;
+ALTER TABLE foo DROP COLUMN IF EXISTS qux;
@@ -0,0 +1,2 @@
+CREATE TABLE foo (bar text);
+ALTER TABLE foo ADD COLUMN baz integer NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+CREATE TABLE foo (
+    bar text,
+    baz int NOT NULL,
+    qux int
+);
+ALTER TABLE foo MODIFY COLUMN bar varchar(255) NOT NULL;
+ALTER TABLE foo CHANGE COLUMN baz quux bigint;
+ALTER TABLE foo CHANGE qux qux text NOT NULL;
@@ -0,0 +1,6 @@
+CREATE TABLE foo (
+    bar text,
+    baz int NOT NULL DEFAULT 0
+);
+ALTER TABLE foo ALTER COLUMN bar SET DEFAULT 'bar';
+ALTER TABLE foo ALTER COLUMN baz DROP DEFAULT;
@@ -0,0 +1,9 @@
+CREATE TABLE foo (
+    bar text,
+    baz int NOT NULL DEFAULT 0
+);
+ALTER TABLE foo ALTER COLUMN bar SET DEFAULT 'bar';
+ALTER TABLE foo ALTER COLUMN bar SET NOT NULL;
+ALTER TABLE foo ALTER COLUMN baz DROP DEFAULT;
+ALTER TABLE foo ALTER COLUMN baz DROP NOT NULL;
+ALTER TABLE foo ALTER COLUMN baz TYPE bigint;
@@ -5,5 +5,6 @@ package querytest
 import ()
 
 type Venue struct {
+	// Identity column
 	ID int64
 }
@@ -1,2 +1,2 @@
-CREATE TABLE venues (id SERIAL PRIMARY KEY);
-ALTER TABLE venues DROP CONSTRAINT venues_id_pkey;
\ No newline at end of file
+CREATE TABLE venues (id SERIAL PRIMARY KEY, CONSTRAINT venues_id_check CHECK (id > 0));
+ALTER TABLE venues DROP CONSTRAINT venues_id_check;
\ No newline at end of file
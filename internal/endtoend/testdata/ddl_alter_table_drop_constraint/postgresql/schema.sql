@@ -1,2 +1,2 @@
 CREATE TABLE venues (id SERIAL PRIMARY KEY);
-ALTER TABLE venues DROP CONSTRAINT venues_id_pkey;
\ No newline at end of file
+ALTER TABLE venues DROP CONSTRAINT venues_pkey;
\ No newline at end of file
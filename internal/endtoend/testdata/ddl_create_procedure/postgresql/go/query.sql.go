@@ -7,6 +7,20 @@ import (
 	"context"
 )
 
+const callInsertData = `-- name: CallInsertData :exec
+CALL insert_data($1, $2)
+`
+
+type CallInsertDataParams struct {
+	A int32
+	B int32
+}
+
+func (q *Queries) CallInsertData(ctx context.Context, arg CallInsertDataParams) error {
+	_, err := q.db.ExecContext(ctx, callInsertData, arg.A, arg.B)
+	return err
+}
+
 const placeholder = `-- name: Placeholder :exec
 SELECT 1
 `
@@ -1,6 +1,5 @@
 -- name: Placeholder :exec
 SELECT 1;
 
--- FIXME: Implement CALL
--- name: CallInsertData :select
-CALL insert_data(1, 2);
+-- name: CallInsertData :exec
+CALL insert_data($1, $2);
@@ -9,9 +9,14 @@ import (
 )
 
 type TddTest struct {
-	TestID    uuid.UUID
-	Title     string
-	Descr     string
+	// Default: uuid_generate_v4()
+	TestID uuid.UUID
+	// Default: ''::text
+	Title string
+	// Default: ''::text
+	Descr string
+	// Default: now()
 	TsCreated time.Time
+	// Default: now()
 	TsUpdated time.Time
 }
@@ -0,0 +1,8 @@
+-- name: ListAuthorNames :many
+SELECT * FROM author_names;
+
+-- name: GetAuthorBio :one
+SELECT biography FROM author_bios WHERE author_id = ?;
+
+-- name: ListCopies :many
+SELECT name, bio FROM authors_copy;
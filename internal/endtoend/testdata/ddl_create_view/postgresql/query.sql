@@ -0,0 +1,11 @@
+-- name: ListAuthorNames :many
+SELECT * FROM author_names;
+
+-- name: GetAuthorBio :one
+SELECT biography FROM author_bios WHERE author_id = $1;
+
+-- name: CountAuthors :one
+SELECT total FROM author_count;
+
+-- name: ListCopies :many
+SELECT name, bio FROM authors_copy;
@@ -0,0 +1,18 @@
+CREATE TABLE authors (
+    id   BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    bio  TEXT
+);
+
+CREATE VIEW author_names AS SELECT id, name FROM authors;
+
+CREATE VIEW author_bios (author_id, biography) AS SELECT id, bio FROM authors;
+
+CREATE MATERIALIZED VIEW author_count AS SELECT count(*) AS total FROM authors;
+
+CREATE TABLE authors_copy AS SELECT name, bio FROM authors;
+
+CREATE VIEW unused AS SELECT name FROM authors;
+DROP VIEW unused;
+
+CREATE OR REPLACE VIEW author_names AS SELECT id, name, bio FROM authors;
@@ -7,6 +7,7 @@ import (
 )
 
 type Description struct {
+	// Generated column
 	ID  string
 	Txt sql.NullString
 }
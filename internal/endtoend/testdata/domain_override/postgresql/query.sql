@@ -0,0 +1,5 @@
+-- name: GetUserByEmail :one
+SELECT * FROM users WHERE email = $1;
+
+-- name: CreateUser :exec
+INSERT INTO users (name, email, score, best, tags) VALUES ($1, $2, $3, $4, $5);
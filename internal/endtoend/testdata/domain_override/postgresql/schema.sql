@@ -0,0 +1,14 @@
+CREATE DOMAIN email AS text CHECK (VALUE LIKE '%@%');
+CREATE DOMAIN username AS varchar(32) NOT NULL;
+CREATE DOMAIN score AS integer DEFAULT 0;
+CREATE DOMAIN positive_score AS score CHECK (VALUE > 0);
+CREATE DOMAIN tags AS text[];
+
+CREATE TABLE users (
+  id bigserial PRIMARY KEY,
+  name username,
+  email email,
+  score score,
+  best positive_score NOT NULL,
+  tags tags
+);
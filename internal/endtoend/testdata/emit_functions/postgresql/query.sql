@@ -0,0 +1,5 @@
+-- name: ListAccounts :many
+SELECT * FROM accounts;
+
+-- name: Deposit :exec
+CALL transfer(0, $1, $2);
@@ -0,0 +1,64 @@
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+
+CREATE SCHEMA billing;
+
+CREATE TABLE accounts (
+    id      BIGSERIAL PRIMARY KEY,
+    owner   TEXT NOT NULL,
+    balance NUMERIC NOT NULL DEFAULT 0
+);
+
+CREATE FUNCTION account_balance(account_id BIGINT) RETURNS NUMERIC AS $$
+    SELECT balance FROM accounts WHERE id = account_id
+$$ LANGUAGE sql STRICT;
+
+CREATE FUNCTION accounts_by_owner(owner_name TEXT) RETURNS SETOF accounts AS $$
+    SELECT * FROM accounts WHERE owner = owner_name
+$$ LANGUAGE sql;
+
+CREATE FUNCTION account_totals(OUT accounts BIGINT, OUT total NUMERIC) AS $$
+    SELECT count(*), sum(balance) FROM accounts
+$$ LANGUAGE sql;
+
+CREATE FUNCTION largest_balances(n INT) RETURNS TABLE (id BIGINT, balance NUMERIC) AS $$
+    SELECT id, balance FROM accounts ORDER BY balance DESC LIMIT n
+$$ LANGUAGE sql;
+
+CREATE FUNCTION touch_accounts() RETURNS void AS $$
+    UPDATE accounts SET balance = balance
+$$ LANGUAGE sql;
+
+CREATE FUNCTION audit_accounts() RETURNS trigger AS $$
+BEGIN
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE PROCEDURE transfer(from_id BIGINT, to_id BIGINT, amount NUMERIC) AS $$
+    UPDATE accounts SET balance = balance - amount WHERE id = from_id;
+    UPDATE accounts SET balance = balance + amount WHERE id = to_id;
+$$ LANGUAGE sql;
+
+CREATE FUNCTION billing.invoice_total(VARIADIC amounts NUMERIC[]) RETURNS NUMERIC AS $$
+    SELECT sum(a) FROM unnest(amounts) AS a
+$$ LANGUAGE sql;
+
+CREATE FUNCTION add(a INT, b INT) RETURNS INT AS $$
+    SELECT a + b
+$$ LANGUAGE sql;
+
+CREATE FUNCTION add(a TEXT, b TEXT) RETURNS TEXT AS $$
+    SELECT a || b
+$$ LANGUAGE sql;
+
+CREATE FUNCTION search_accounts(q TEXT, ctx INT) RETURNS SETOF accounts AS $$
+    SELECT * FROM accounts WHERE owner LIKE q LIMIT ctx
+$$ LANGUAGE sql;
+
+CREATE FUNCTION scale_balance(NUMERIC, NUMERIC) RETURNS NUMERIC AS $$
+    SELECT $1 * $2
+$$ LANGUAGE sql;
+
+CREATE FUNCTION find_accounts(q TEXT) RETURNS SETOF accounts AS $$
+    SELECT * FROM accounts WHERE owner = q
+$$ LANGUAGE sql;
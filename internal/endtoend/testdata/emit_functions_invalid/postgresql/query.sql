@@ -0,0 +1,5 @@
+-- name: ListAccounts :many
+SELECT * FROM accounts;
+
+-- name: TouchAccounts :exec
+UPDATE accounts SET id = id;
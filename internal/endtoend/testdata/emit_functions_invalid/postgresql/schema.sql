@@ -0,0 +1,5 @@
+CREATE TABLE accounts (id BIGSERIAL PRIMARY KEY);
+
+CREATE FUNCTION touch_accounts() RETURNS void AS $$
+    UPDATE accounts SET id = id
+$$ LANGUAGE sql;
@@ -0,0 +1,29 @@
+CREATE TABLE orders (
+    id         integer  PRIMARY KEY,
+    price      decimal  NOT NULL,
+    qty        integer  NOT NULL,
+    discount   decimal,
+    note       text,
+    created_at datetime NOT NULL
+);
+
+-- name: Arithmetic :many
+SELECT price * qty AS total, qty + 1 AS next_qty, price * discount AS discounted, -qty AS negated
+FROM orders;
+
+-- name: Aggregates :one
+SELECT COUNT(*) AS total, COUNT(discount) AS discounts, SUM(qty) AS qty_sum, MAX(price) AS max_price
+FROM orders;
+
+-- name: Windows :many
+SELECT id, ROW_NUMBER() OVER (ORDER BY id) AS rn
+FROM orders;
+
+-- name: Functions :many
+SELECT COALESCE(discount, 0) AS discount_or_zero, CONCAT(note, 'x') AS concatenated
+FROM orders;
+
+-- name: Predicates :many
+SELECT price > 10 AS expensive, note IS NULL AS missing_note,
+    CASE WHEN qty > 10 THEN 'bulk' ELSE 'single' END AS size, CASE WHEN qty > 10 THEN note END AS bulk_note
+FROM orders;
@@ -0,0 +1,34 @@
+CREATE TABLE orders (
+    id         integer   PRIMARY KEY,
+    price      numeric   NOT NULL,
+    qty        integer   NOT NULL,
+    discount   numeric,
+    note       text,
+    created_at timestamp NOT NULL,
+    shipped_at timestamp
+);
+
+-- name: Arithmetic :many
+SELECT price * qty AS total, qty + 1 AS next_qty, price * discount AS discounted, -qty AS negated
+FROM orders;
+
+-- name: Aggregates :one
+SELECT count(*) AS total, count(discount) AS discounts, sum(qty) AS qty_sum, max(price) AS max_price, min(created_at) AS first_created
+FROM orders;
+
+-- name: Windows :many
+SELECT id, row_number() OVER (ORDER BY id) AS rn, lag(price) OVER (ORDER BY id) AS prev_price
+FROM orders;
+
+-- name: Functions :many
+SELECT lower(note) AS lower_note, upper('x') AS upper_const, note || 'x' AS concatenated, coalesce(discount, 0) AS discount_or_zero, nullif(qty, 0) AS nonzero_qty
+FROM orders;
+
+-- name: Subqueries :many
+SELECT id, (SELECT max(qty) FROM orders) AS max_qty, EXISTS (SELECT 1 FROM orders o2 WHERE o2.id > orders.id) AS has_more, ARRAY(SELECT id FROM orders) AS all_ids
+FROM orders;
+
+-- name: Predicates :many
+SELECT price > 10 AS expensive, note IS NULL AS missing_note, note LIKE 'a%' AS starts_with_a, shipped_at - created_at AS shipping_time, created_at + interval '1 day' AS next_day,
+    CASE WHEN qty > 10 THEN 'bulk' ELSE 'single' END AS size, CASE WHEN qty > 10 THEN note END AS bulk_note
+FROM orders;
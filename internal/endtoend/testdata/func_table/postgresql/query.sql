@@ -0,0 +1,29 @@
+-- name: AllProducts :many
+SELECT * FROM all_products();
+
+-- name: ProductStats :one
+SELECT * FROM product_stats();
+
+-- name: SearchProducts :many
+SELECT * FROM search_products($1);
+
+-- name: SearchProductsAliased :many
+SELECT p.product_id, p.product_name FROM search_products($1) AS p(product_id, product_name);
+
+-- name: ProductTags :many
+SELECT t.tag, t.position FROM products, unnest(products.tags) WITH ORDINALITY AS t(tag, position)
+WHERE products.id = $1;
+
+-- name: Series :many
+SELECT n FROM generate_series(1, $1::int) AS n;
+
+-- name: ProductAttrs :many
+SELECT attrs.key, attrs.value FROM products, jsonb_each(products.attrs) AS attrs
+WHERE products.id = $1;
+
+-- name: ProductRecord :many
+SELECT r.a, r.b FROM products, jsonb_to_record(products.attrs) AS r(a INT, b TEXT);
+
+-- name: ProductsWithSearch :many
+SELECT products.id, s.name FROM products
+LEFT JOIN LATERAL search_products(products.name) s ON true;
@@ -0,0 +1,19 @@
+CREATE TABLE products (
+    id    SERIAL PRIMARY KEY,
+    name  TEXT NOT NULL,
+    price NUMERIC NOT NULL,
+    tags  TEXT[] NOT NULL,
+    attrs JSONB NOT NULL
+);
+
+CREATE FUNCTION all_products() RETURNS SETOF products AS $$
+    SELECT * FROM products
+$$ LANGUAGE sql;
+
+CREATE FUNCTION product_stats(OUT total BIGINT, OUT avg_price NUMERIC) AS $$
+    SELECT count(*), avg(price) FROM products
+$$ LANGUAGE sql;
+
+CREATE FUNCTION search_products(term TEXT) RETURNS TABLE (id INT, name TEXT) AS $$
+    SELECT id, name FROM products WHERE name LIKE term
+$$ LANGUAGE sql;
@@ -0,0 +1,11 @@
+-- name: InsertOverridingSystemValue :exec
+INSERT INTO orders (id, price) OVERRIDING SYSTEM VALUE VALUES ($1, $2);
+
+-- name: InsertOverridingUserValue :exec
+INSERT INTO orders (id, price) OVERRIDING USER VALUE VALUES ($1, $2);
+
+-- name: InsertDefault :exec
+INSERT INTO orders (id, price, total) VALUES (DEFAULT, $1, DEFAULT);
+
+-- name: UpdateDefault :exec
+UPDATE orders SET price = $1, total = DEFAULT;
@@ -0,0 +1,5 @@
+-- name: InsertTotal :exec
+INSERT INTO orders (price, total) VALUES (?, ?);
+
+-- name: UpdateTotal :exec
+UPDATE orders SET total = ?;
@@ -0,0 +1,22 @@
+-- name: InsertTotal :exec
+INSERT INTO orders (price, total) VALUES ($1, $2);
+
+-- name: InsertID :exec
+INSERT INTO orders (id, price) VALUES ($1, $2);
+
+-- name: InsertSelect :exec
+INSERT INTO orders (id, price) SELECT id, price FROM orders;
+
+-- name: UpdateTotal :exec
+UPDATE orders SET total = $1;
+
+-- name: UpdateID :exec
+UPDATE orders SET id = $1;
+
+-- name: InsertWithoutColumns :exec
+INSERT INTO orders VALUES
+  (DEFAULT, DEFAULT, $1, 1, DEFAULT),
+  (DEFAULT, DEFAULT, $2, 1, $3);
+
+-- name: InsertSelectWithoutColumns :exec
+INSERT INTO orders SELECT * FROM orders;
@@ -0,0 +1,10 @@
+CREATE TABLE orders (
+  id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  seq int GENERATED BY DEFAULT AS IDENTITY,
+  price numeric NOT NULL,
+  quantity int NOT NULL DEFAULT 1,
+  total numeric GENERATED ALWAYS AS (price * quantity) STORED,
+  created_at timestamp NOT NULL DEFAULT now()
+);
+
+ALTER TABLE orders ADD COLUMN note text NOT NULL DEFAULT '';
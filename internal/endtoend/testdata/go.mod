@@ -5,7 +5,9 @@ go 1.16
 require (
 	github.com/gofrs/uuid v4.0.0+incompatible
 	github.com/google/uuid v1.2.0
-	github.com/jackc/pgtype v1.6.2
+	github.com/jackc/pgconn v1.8.1
+	github.com/jackc/pgtype v1.7.0
+	github.com/jackc/pgx/v4 v4.11.0
 	github.com/kyleconroy/sqlc-testdata v0.0.0-20200512000015-15313bc43553
 	github.com/lib/pq v1.9.0
 	github.com/volatiletech/null/v8 v8.1.2
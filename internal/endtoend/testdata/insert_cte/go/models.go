@@ -8,8 +8,10 @@ import (
 )
 
 type Td3Code struct {
-	ID        int32
+	ID int32
+	// Default: now()
 	TsCreated time.Time
+	// Default: now()
 	TsUpdated time.Time
 	CreatedBy string
 	UpdatedBy string
@@ -19,8 +21,10 @@ type Td3Code struct {
 }
 
 type Td3TestCode struct {
-	ID        int32
+	ID int32
+	// Default: now()
 	TsCreated time.Time
+	// Default: now()
 	TsUpdated time.Time
 	CreatedBy string
 	UpdatedBy string
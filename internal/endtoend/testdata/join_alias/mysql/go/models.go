@@ -7,10 +7,12 @@ import (
 )
 
 type Bar struct {
+	// Identity column
 	ID    int64
 	Title sql.NullString
 }
 
 type Foo struct {
+	// Identity column
 	ID int64
 }
@@ -0,0 +1,41 @@
+CREATE TABLE authors (
+  id   INT PRIMARY KEY,
+  name TEXT NOT NULL
+);
+CREATE TABLE books (
+  id        INT PRIMARY KEY,
+  author_id INT NOT NULL,
+  title     TEXT NOT NULL
+);
+CREATE TABLE reviews (
+  id      INT PRIMARY KEY,
+  book_id INT NOT NULL,
+  body    TEXT NOT NULL
+);
+
+-- name: AliasedSelfJoin :many
+SELECT a.name, b.name AS other_name
+FROM authors a
+LEFT JOIN authors b ON b.id = a.id + 1;
+
+-- name: StarExpansion :many
+SELECT *
+FROM authors
+LEFT JOIN books ON books.author_id = authors.id;
+
+-- name: Parenthesized :many
+SELECT authors.name, books.title, reviews.body
+FROM authors
+LEFT JOIN (books INNER JOIN reviews ON reviews.book_id = books.id)
+  ON books.author_id = authors.id;
+
+-- name: NestedRight :many
+SELECT authors.name, books.title, reviews.body
+FROM authors
+INNER JOIN books ON books.author_id = authors.id
+RIGHT JOIN reviews ON reviews.book_id = books.id;
+
+-- name: Subquery :many
+SELECT authors.name, recent.title
+FROM authors
+LEFT JOIN (SELECT author_id, title FROM books) recent ON recent.author_id = authors.id;
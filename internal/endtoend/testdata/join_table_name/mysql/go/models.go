@@ -5,10 +5,13 @@ package querytest
 import ()
 
 type Bar struct {
+	// Identity column
 	ID int64
 }
 
 type Foo struct {
-	ID  int64
+	// Identity column
+	ID int64
+	// Identity column
 	Bar int64
 }
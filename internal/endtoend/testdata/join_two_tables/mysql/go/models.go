@@ -5,14 +5,18 @@ package querytest
 import ()
 
 type Bar struct {
+	// Identity column
 	ID int64
 }
 
 type Baz struct {
+	// Identity column
 	ID int64
 }
 
 type Foo struct {
+	// Identity column
 	BarID int64
+	// Identity column
 	BazID int64
 }
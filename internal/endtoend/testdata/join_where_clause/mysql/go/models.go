@@ -5,10 +5,12 @@ package querytest
 import ()
 
 type Bar struct {
+	// Identity column
 	ID    int64
 	Owner string
 }
 
 type Foo struct {
+	// Identity column
 	Barid int64
 }
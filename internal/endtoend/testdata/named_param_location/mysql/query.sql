@@ -0,0 +1,13 @@
+-- name: MultiLine :many
+SELECT id FROM foo WHERE name = sqlc.arg(
+  name
+) AND age > sqlc.arg(min_age);
+
+-- name: Repeated :many
+SELECT id FROM foo WHERE name = sqlc.arg(name) OR bio = sqlc.arg(name) OR age = sqlc.arg(age);
+
+-- name: Order :exec
+UPDATE foo SET name = sqlc.arg(name) WHERE bio = sqlc.arg(bio);
+
+-- name: RepeatedID :many
+SELECT id FROM foo WHERE id = sqlc.arg(name) OR name = sqlc.arg(name);
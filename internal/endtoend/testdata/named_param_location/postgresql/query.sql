@@ -0,0 +1,18 @@
+-- name: MultiLine :many
+SELECT id FROM foo WHERE name = sqlc.arg(
+  name
+) AND age > sqlc.arg(min_age);
+
+-- name: Repeated :many
+SELECT id FROM foo WHERE name = @name OR bio = @name OR age = @age;
+
+-- name: Order :exec
+WITH t AS (SELECT id FROM foo WHERE age = @age)
+UPDATE foo SET name = @name FROM t WHERE foo.id = t.id AND bio = @bio;
+
+-- name: Spaced :many
+SELECT id FROM foo WHERE name = @ name;
+
+-- name: Quoted :many
+SELECT id FROM foo WHERE name = @"Name" AND bio = @bio OR age = @age::int;
+
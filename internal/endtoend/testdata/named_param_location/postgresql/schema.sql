@@ -0,0 +1 @@
+CREATE TABLE foo (id bigint PRIMARY KEY, name text NOT NULL, bio text, age int NOT NULL);
@@ -0,0 +1,5 @@
+-- name: PositionalAndFunc :many
+SELECT id FROM foo WHERE name = ? AND age = sqlc.arg(age);
+
+-- name: FuncAndPositional :many
+SELECT id FROM foo WHERE name = sqlc.arg(name) AND age = ?;
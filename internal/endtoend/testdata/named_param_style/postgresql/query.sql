@@ -0,0 +1,13 @@
+-- name: SignAndFunc :many
+SELECT id FROM foo WHERE name = @name AND age = sqlc.arg(age);
+
+-- name: FuncAndSign :many
+SELECT id FROM foo WHERE name = sqlc.narg(name) AND age = @age;
+
+-- name: PositionalAndSign :many
+SELECT id FROM foo WHERE name = $1 AND age = @age;
+
+-- name: SignAndPositional :many
+SELECT id FROM foo
+WHERE name = @name
+  AND age = $2;
@@ -7,6 +7,7 @@ import (
 )
 
 type User struct {
+	// Identity column
 	ID        int32
 	FirstName sql.NullString
 	LastName  sql.NullString
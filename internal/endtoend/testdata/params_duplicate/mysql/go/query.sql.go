@@ -13,13 +13,8 @@ SELECT first_name from
 users where (? = id OR ? = 0)
 `
 
-type SelectUserByIDParams struct {
-	Column1 interface{}
-	ID      interface{}
-}
-
-func (q *Queries) SelectUserByID(ctx context.Context, arg SelectUserByIDParams) ([]sql.NullString, error) {
-	rows, err := q.db.QueryContext(ctx, selectUserByID, arg.Column1, arg.ID)
+func (q *Queries) SelectUserByID(ctx context.Context, id int32) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, selectUserByID, id, id)
 	if err != nil {
 		return nil, err
 	}
@@ -48,13 +43,8 @@ WHERE first_name = ?
    OR last_name = ?
 `
 
-type SelectUserByNameParams struct {
-	FirstName sql.NullString
-	Name      sql.NullString
-}
-
-func (q *Queries) SelectUserByName(ctx context.Context, arg SelectUserByNameParams) ([]sql.NullString, error) {
-	rows, err := q.db.QueryContext(ctx, selectUserByName, arg.FirstName, arg.Name)
+func (q *Queries) SelectUserByName(ctx context.Context, name sql.NullString) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, selectUserByName, name, name)
 	if err != nil {
 		return nil, err
 	}
@@ -82,12 +72,12 @@ users where (? = id OR  ? = 0)
 `
 
 type SelectUserQuestionParams struct {
-	Column1 interface{}
-	Column2 interface{}
+	ID      int32
+	Column2 int32
 }
 
 func (q *Queries) SelectUserQuestion(ctx context.Context, arg SelectUserQuestionParams) ([]sql.NullString, error) {
-	rows, err := q.db.QueryContext(ctx, selectUserQuestion, arg.Column1, arg.Column2)
+	rows, err := q.db.QueryContext(ctx, selectUserQuestion, arg.ID, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,32 @@
+-- name: Limit :many
+SELECT * FROM foo LIMIT ? OFFSET ?;
+
+-- name: Between :many
+SELECT * FROM foo WHERE age BETWEEN ? AND ?;
+
+-- name: InList :many
+SELECT * FROM foo WHERE id IN (?, ?);
+
+-- name: CaseWhen2 :many
+SELECT CASE WHEN age > ? THEN ? ELSE name END FROM foo;
+
+-- name: CaseSimple :many
+SELECT * FROM foo WHERE CASE age WHEN ? THEN true ELSE false END;
+
+-- name: Exists :many
+SELECT * FROM foo WHERE EXISTS (SELECT 1 FROM bar WHERE bar.foo_id = foo.id AND score = ?);
+
+-- name: UpdateJoin :exec
+UPDATE foo JOIN bar ON bar.foo_id = foo.id SET foo.name = ? WHERE bar.score = ?;
+
+-- name: Reverse :many
+SELECT * FROM foo WHERE ? = age;
+
+-- name: InSub :many
+SELECT * FROM foo WHERE id IN (SELECT foo_id FROM bar WHERE score > ?);
+
+-- name: Coalesce :many
+SELECT * FROM foo WHERE COALESCE(age, ?) = 3;
+
+-- name: NotBool :many
+SELECT * FROM foo WHERE ?;
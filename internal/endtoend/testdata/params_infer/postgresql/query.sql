@@ -0,0 +1,47 @@
+-- name: Limit :many
+SELECT * FROM foo LIMIT $1 OFFSET $2;
+
+-- name: Between :many
+SELECT * FROM foo WHERE age BETWEEN $1 AND $2;
+
+-- name: InList :many
+SELECT * FROM foo WHERE id IN ($1, $2);
+
+-- name: CaseWhen :many
+SELECT CASE WHEN $1::bool THEN name ELSE 'x' END FROM foo;
+
+-- name: CaseWhen2 :many
+SELECT CASE WHEN age > $1 THEN $2 ELSE name END FROM foo;
+
+-- name: CaseSimple :many
+SELECT * FROM foo WHERE CASE age WHEN $1 THEN true ELSE false END;
+
+-- name: Any :many
+SELECT * FROM foo WHERE id = ANY($1::bigint[]);
+
+-- name: AnyPlain :many
+SELECT * FROM foo WHERE id = ANY($1);
+
+-- name: Exists :many
+SELECT * FROM foo WHERE EXISTS (SELECT 1 FROM bar WHERE bar.foo_id = foo.id AND score = $1);
+
+-- name: UpdateFrom :exec
+UPDATE foo SET name = $1 FROM bar WHERE bar.foo_id = foo.id AND bar.score = $2;
+
+-- name: Reverse :many
+SELECT * FROM foo WHERE $1 = age;
+
+-- name: Arith :many
+SELECT * FROM foo WHERE age + $1 > 10;
+
+-- name: InSub :many
+SELECT * FROM foo WHERE id IN (SELECT foo_id FROM bar WHERE score > $1);
+
+-- name: ScalarSub :many
+SELECT * FROM foo WHERE age = (SELECT score FROM bar WHERE id = $1);
+
+-- name: Coalesce :many
+SELECT * FROM foo WHERE COALESCE(age, $1) = 3;
+
+-- name: NotBool :many
+SELECT * FROM foo WHERE $1;
@@ -0,0 +1,2 @@
+CREATE TABLE foo (id bigint NOT NULL, name text NOT NULL, age int, tags text[] NOT NULL);
+CREATE TABLE bar (id bigint NOT NULL, foo_id bigint NOT NULL, score int NOT NULL);
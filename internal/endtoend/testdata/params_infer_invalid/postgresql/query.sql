@@ -0,0 +1,8 @@
+-- name: SelectParam :one
+SELECT $1;
+
+-- name: NullTest :many
+SELECT * FROM foo WHERE $1 IS NULL;
+
+-- name: CompareParams :many
+SELECT * FROM foo WHERE $1 = $2;
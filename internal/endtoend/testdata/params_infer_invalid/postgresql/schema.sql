@@ -0,0 +1 @@
+CREATE TABLE foo (id bigint NOT NULL, name text NOT NULL);
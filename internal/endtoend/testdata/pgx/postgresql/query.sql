@@ -0,0 +1,28 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+WHERE tags && $1
+ORDER BY name;
+
+-- name: CreateAuthor :one
+INSERT INTO authors (
+  name, bio, tags
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
+
+-- name: DeleteAuthorsByName :execrows
+DELETE FROM authors
+WHERE name = $1;
+
+-- name: UpdateAuthorBio :execresult
+UPDATE authors SET bio = $2
+WHERE id = $1;
@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+    id         BIGSERIAL PRIMARY KEY,
+    name       TEXT NOT NULL,
+    bio        TEXT,
+    age        INTEGER,
+    rating     NUMERIC,
+    active     BOOLEAN,
+    born       DATE,
+    created_at TIMESTAMPTZ,
+    tags       TEXT[] NOT NULL,
+    settings   JSONB
+);
@@ -0,0 +1,15 @@
+-- name: ListOrders :many
+-- Orders created before the cutoff
+-- @param cutoff
+-- @column metadata com.fasterxml.jackson.databind.JsonNode
+SELECT id, metadata FROM orders WHERE created_at < $1;
+
+-- name: GetOrderByExternalID :one
+-- @param external_id java.util.UUID
+-- @column external_id java.util.UUID
+SELECT * FROM orders WHERE external_id = $1;
+
+-- name: UpdateStatus :exec
+-- @param new_status
+-- @param order_id
+UPDATE orders SET status = $1 WHERE id = $2;
@@ -0,0 +1,9 @@
+/* name: ListOrders :many */
+/* @param cutoff */
+/* @column metadata encoding/json.RawMessage */
+SELECT id, metadata FROM orders WHERE created_at < ?;
+
+-- name: UpdateStatus :exec
+-- @param new_status
+-- @param order_id int32
+UPDATE orders SET status = ? WHERE id = ?;
@@ -0,0 +1,7 @@
+CREATE TABLE orders (
+    id bigint PRIMARY KEY AUTO_INCREMENT,
+    external_id text NOT NULL,
+    status text,
+    metadata json NOT NULL,
+    created_at timestamp NOT NULL
+);
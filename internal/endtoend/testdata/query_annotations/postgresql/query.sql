@@ -0,0 +1,32 @@
+-- name: ListOrders :many
+-- Orders created before the cutoff
+-- @param cutoff
+-- @column metadata encoding/json.RawMessage
+SELECT id, metadata FROM orders WHERE created_at < $1;
+
+-- name: GetOrderByExternalID :one
+-- @param external_id github.com/google/uuid.UUID
+-- @column external_id github.com/google/uuid.UUID
+SELECT * FROM orders WHERE external_id = $1;
+
+-- name: UpdateStatus :exec
+-- @param new_status
+-- @param order_id
+UPDATE orders SET status = $1 WHERE id = $2;
+
+-- name: GetMetadata :one
+-- @column metadata github.com/jackc/pgtype.JSONB
+SELECT metadata FROM orders WHERE id = sqlc.arg(order_id);
+
+-- name: GetOrderStatus :one
+-- @param id the ID of the order to look up
+SELECT status FROM orders WHERE id = $1;
+
+-- name: ListExternalIDs :one
+-- @param cutoff *time.Time
+-- @column external_ids []github.com/google/uuid.UUID
+SELECT array_agg(external_id)::text[] AS external_ids FROM orders WHERE created_at < $1;
+
+-- name: ListStatuses :one
+-- @column statuses []string
+SELECT array_agg(status)::text[] AS statuses FROM orders;
@@ -0,0 +1,25 @@
+-- name: TooManyParams :many
+-- @param cutoff
+-- @param extra
+SELECT id FROM orders WHERE created_at < $1;
+
+-- name: MissingColumn :many
+-- @column meta encoding/json.RawMessage
+SELECT id, metadata FROM orders;
+
+-- name: NamedMismatch :many
+-- @param status
+SELECT id FROM orders WHERE created_at < @cutoff AND status = @status;
+
+-- name: MissingType :many
+-- @column metadata
+SELECT id, metadata FROM orders;
+
+-- name: DuplicateColumn :many
+-- @column metadata encoding/json.RawMessage
+-- @column metadata string
+SELECT id, metadata FROM orders;
+
+-- name: InvalidName :many
+-- @param 1cutoff
+SELECT id FROM orders WHERE created_at < $1;
@@ -0,0 +1,7 @@
+CREATE TABLE orders (
+    id bigserial PRIMARY KEY,
+    external_id text NOT NULL,
+    status text,
+    metadata jsonb NOT NULL,
+    created_at timestamp NOT NULL
+);
@@ -5,5 +5,6 @@ package querytest
 import ()
 
 type FooBar struct {
+	// Identity column
 	ID int64
 }
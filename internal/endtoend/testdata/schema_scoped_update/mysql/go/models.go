@@ -5,6 +5,7 @@ package querytest
 import ()
 
 type FooBar struct {
+	// Identity column
 	ID   int64
 	Name string
 }
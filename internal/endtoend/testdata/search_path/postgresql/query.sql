@@ -0,0 +1,23 @@
+-- name: GetUser :one
+SELECT * FROM users WHERE id = $1;
+
+-- name: ListUsersByStatus :many
+SELECT id, name FROM users WHERE status = $1;
+
+-- name: CreateUser :one
+INSERT INTO users (name, status) VALUES ($1, $2) RETURNING *;
+
+-- name: ListPostsWithAuthor :many
+SELECT p.title, u.name FROM posts p JOIN users u ON u.id = p.user_id;
+
+-- name: CountUsers :one
+SELECT user_count();
+
+-- name: ArchiveUsers :exec
+UPDATE users SET status = $1::status WHERE id = ANY($2::bigint[]);
+
+-- name: ListAuditLog :many
+SELECT * FROM audit_log;
+
+-- name: ListUserIDs :many
+SELECT id FROM app.users;
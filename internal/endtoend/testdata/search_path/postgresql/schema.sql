@@ -0,0 +1,29 @@
+CREATE SCHEMA app;
+
+SET search_path TO app, public;
+
+CREATE TYPE status AS ENUM ('active', 'archived');
+
+CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    status status NOT NULL
+);
+
+CREATE TABLE posts (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users (id),
+    title TEXT NOT NULL
+);
+
+CREATE FUNCTION user_count() RETURNS bigint AS $$
+    SELECT count(*) FROM users
+$$ LANGUAGE sql;
+
+RESET search_path;
+
+-- Created in the public schema
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    note TEXT NOT NULL
+);
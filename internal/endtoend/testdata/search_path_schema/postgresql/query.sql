@@ -0,0 +1,5 @@
+-- name: ListInvoices :many
+SELECT * FROM invoices WHERE author_id = $1;
+
+-- name: GetAuthor :one
+SELECT name FROM authors WHERE id = $1;
@@ -0,0 +1,15 @@
+CREATE SCHEMA app;
+CREATE SCHEMA billing;
+
+SET search_path TO billing, app;
+
+CREATE TABLE app.authors (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE TABLE invoices (
+    id SERIAL PRIMARY KEY,
+    author_id INT NOT NULL REFERENCES authors (id),
+    amount NUMERIC NOT NULL
+);
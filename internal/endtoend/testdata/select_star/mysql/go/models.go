@@ -7,6 +7,7 @@ import (
 )
 
 type User struct {
+	// Identity column
 	ID        int32
 	FirstName string
 	LastName  sql.NullString
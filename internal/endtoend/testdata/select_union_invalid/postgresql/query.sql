@@ -0,0 +1,19 @@
+-- name: UnionColumns :many
+SELECT id FROM foo
+UNION
+SELECT id, name FROM foo;
+
+-- name: ExceptColumns :many
+SELECT id, name FROM foo
+EXCEPT
+SELECT id FROM foo;
+
+-- name: UnionTypes :many
+SELECT name FROM foo
+UNION
+SELECT id FROM foo;
+
+-- name: UnionTypesColumn :many
+SELECT id, name FROM foo
+UNION ALL
+SELECT id, id FROM foo;
@@ -0,0 +1 @@
+CREATE TABLE foo (id int NOT NULL, name text NOT NULL);
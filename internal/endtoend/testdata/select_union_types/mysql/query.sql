@@ -0,0 +1,24 @@
+-- name: UnionNull :many
+SELECT id, name FROM foo WHERE id = ?
+UNION
+SELECT NULL, name FROM bar WHERE name = ?;
+
+-- name: UnionWiden :many
+SELECT id, amount FROM foo
+UNION ALL
+SELECT id, amount FROM bar WHERE id = ?;
+
+-- name: UnionParam :many
+SELECT id, name FROM foo
+UNION
+SELECT ?, name FROM bar;
+
+-- name: UnionLiteral :many
+SELECT id FROM foo
+UNION ALL
+SELECT 'none';
+
+-- name: UnionMixed :many
+SELECT name FROM foo
+UNION
+SELECT id FROM foo;
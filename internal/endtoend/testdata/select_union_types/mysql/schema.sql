@@ -0,0 +1,2 @@
+CREATE TABLE foo (id int NOT NULL, name text NOT NULL, amount smallint NOT NULL);
+CREATE TABLE bar (id bigint NOT NULL, name varchar(255), amount decimal(10, 2) NOT NULL);
@@ -0,0 +1,36 @@
+-- name: UnionNull :many
+SELECT id, name FROM foo WHERE id = $1
+UNION
+SELECT NULL, name FROM bar WHERE name = $2;
+
+-- name: UnionWiden :many
+SELECT id, amount FROM foo
+UNION ALL
+SELECT id, amount FROM bar WHERE id = $1;
+
+-- name: UnionParam :many
+SELECT id, name FROM foo
+UNION
+SELECT $1, name FROM bar;
+
+-- name: Intersect :many
+SELECT id, name FROM foo
+INTERSECT
+SELECT id, name FROM bar;
+
+-- name: Except :many
+SELECT name FROM bar
+EXCEPT
+SELECT name FROM foo;
+
+-- name: UnionChain :many
+SELECT id FROM foo
+UNION
+SELECT id FROM bar
+UNION
+SELECT $1;
+
+-- name: UnionLiteral :many
+SELECT id FROM foo
+UNION ALL
+SELECT '0';
@@ -0,0 +1,14 @@
+-- name: NextInvoiceNumber :one
+SELECT nextval('invoice_numbers');
+
+-- name: CurrentOrderID :one
+SELECT currval('orders_id_seq');
+
+-- name: NextTicket :one
+SELECT nextval('"orders_ticket_seq"'::regclass);
+
+-- name: NextArchiveNumber :one
+SELECT nextval('Archive_Numbers');
+
+-- name: ResetRefunds :one
+SELECT setval('billing.refund_numbers', $1) AS refund_number;
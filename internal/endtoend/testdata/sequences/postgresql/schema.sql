@@ -0,0 +1,17 @@
+CREATE SEQUENCE invoice_numbers AS integer START 1000;
+CREATE SEQUENCE IF NOT EXISTS invoice_numbers;
+CREATE SEQUENCE legacy_numbers;
+ALTER SEQUENCE legacy_numbers RENAME TO archive_numbers;
+CREATE SEQUENCE scratch;
+DROP SEQUENCE scratch;
+
+CREATE SCHEMA billing;
+CREATE SEQUENCE billing.refund_numbers;
+
+CREATE TABLE orders (
+    id serial PRIMARY KEY,
+    ticket bigint GENERATED BY DEFAULT AS IDENTITY,
+    invoice_number integer NOT NULL DEFAULT nextval('invoice_numbers')
+);
+
+ALTER SEQUENCE invoice_numbers OWNED BY orders.invoice_number;
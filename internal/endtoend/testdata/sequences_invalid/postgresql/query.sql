@@ -0,0 +1,11 @@
+-- name: NextInvoiceNumber :one
+SELECT nextval('invoice_number');
+
+-- name: CurrentOrderID :one
+SELECT currval('orders_id_seq');
+
+-- name: NextOrder :one
+SELECT nextval('orders');
+
+-- name: QuotedName :one
+SELECT nextval('"Invoice_Numbers"');
@@ -0,0 +1,8 @@
+CREATE SEQUENCE invoice_numbers;
+
+CREATE TABLE orders (
+    id serial PRIMARY KEY,
+    notes text
+);
+
+ALTER TABLE orders DROP COLUMN id;
@@ -14,3 +14,6 @@ select id, first_name from users where id = sqlc.arg(sqlc.arg(target_id));
 
 -- name: InvalidArgPlaceholder :one
 select id, first_name from users where id = sqlc.arg($1);
+
+-- name: TooManyNullableArgs :one
+select id, first_name from users where id = sqlc.narg('foo', 'bar');
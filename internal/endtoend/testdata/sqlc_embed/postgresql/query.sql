@@ -0,0 +1,35 @@
+CREATE TABLE users (
+    id integer NOT NULL PRIMARY KEY,
+    name text NOT NULL,
+    age integer,
+    tags text[] NOT NULL
+);
+
+CREATE TABLE posts (
+    id integer NOT NULL PRIMARY KEY,
+    user_id integer NOT NULL
+);
+
+-- name: Only :one
+SELECT sqlc.embed(users) FROM users;
+
+-- name: WithAlias :one
+SELECT sqlc.embed(u) FROM users u;
+
+-- name: WithColumns :many
+SELECT sqlc.embed(users), upper(name) AS upper_name FROM users;
+
+-- name: WithAsterisk :one
+SELECT sqlc.embed(users), * FROM users;
+
+-- name: Duplicate :one
+SELECT sqlc.embed(users), sqlc.embed(users) FROM users;
+
+-- name: Join :one
+SELECT sqlc.embed(u), sqlc.embed(p) FROM posts p
+INNER JOIN users u ON u.id = p.user_id
+WHERE p.id = $1;
+
+-- name: LeftJoin :many
+SELECT sqlc.embed(u), p.id AS post_id FROM users u
+LEFT JOIN posts p ON p.user_id = u.id;
@@ -0,0 +1,17 @@
+CREATE TABLE users (
+    id integer NOT NULL PRIMARY KEY,
+    name text NOT NULL
+);
+
+-- name: Missing :one
+SELECT sqlc.embed(posts) FROM users;
+
+-- name: Where :many
+SELECT id FROM users WHERE sqlc.embed(users) IS NOT NULL;
+
+-- name: Subquery :many
+SELECT sqlc.embed(sub) FROM (SELECT id FROM users) sub;
+
+-- name: LeftJoin :many
+SELECT sqlc.embed(u), sqlc.embed(f) FROM users u
+LEFT JOIN users f ON f.id = u.id + 1;
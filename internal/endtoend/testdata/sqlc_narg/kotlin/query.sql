@@ -0,0 +1,19 @@
+CREATE TABLE foo (
+    name   text not null,
+    status text not null,
+    bio    text
+);
+
+-- name: ListFooByStatus :many
+SELECT name FROM foo
+WHERE (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status));
+
+-- name: ListFooByName :many
+SELECT name FROM foo WHERE name = sqlc.narg('name');
+
+-- name: ListFooByNameAndBio :many
+SELECT name FROM foo
+WHERE name = sqlc.arg(name) AND bio = sqlc.narg(bio);
+
+-- name: UpdateFooBio :exec
+UPDATE foo SET bio = sqlc.narg(bio) WHERE name = sqlc.arg(name);
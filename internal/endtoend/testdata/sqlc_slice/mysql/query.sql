@@ -0,0 +1,32 @@
+CREATE TABLE foo (
+    id   int  not null,
+    name text not null,
+    bio  text
+);
+
+/* name: FuncParamIdent :many */
+SELECT name FROM foo WHERE id IN (sqlc.slice(ids));
+
+/* name: FuncParamString :many */
+SELECT name FROM foo WHERE name IN (sqlc.slice('names'));
+
+/* name: FuncNullable :many */
+SELECT name FROM foo WHERE bio IN (sqlc.slice(bios));
+
+/* name: SliceAndArgs :many */
+SELECT name FROM foo
+WHERE name = sqlc.arg(name)
+  AND id IN (sqlc.slice(ids))
+  AND bio = sqlc.arg(bio);
+
+/* name: DeleteFoo :execrows */
+DELETE FROM foo WHERE id IN (sqlc.slice(ids));
+
+/* name: PositionalIn :many */
+SELECT name FROM foo WHERE id IN (?, ?);
+
+/* name: RepeatedSlice :many */
+SELECT name FROM foo WHERE id IN (sqlc.slice(ids)) OR id + 1 IN (sqlc.slice(ids));
+
+/* name: RepeatedSliceName :many */
+SELECT name FROM foo WHERE id IN (sqlc.slice(ids)) AND name <> sqlc.arg(name) AND id NOT IN (sqlc.slice(ids));
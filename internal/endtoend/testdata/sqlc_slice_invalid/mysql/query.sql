@@ -0,0 +1,7 @@
+CREATE TABLE foo (id int not null);
+
+/* name: ListFoo :many */
+SELECT id FROM foo WHERE id = sqlc.slice(ids);
+
+/* name: ListFooNested :many */
+SELECT id FROM foo WHERE id IN (sqlc.slice(ids) + 1);
@@ -0,0 +1,4 @@
+CREATE TABLE foo (id int not null);
+
+-- name: ListFoo :many
+SELECT id FROM foo WHERE id IN (sqlc.slice(ids));
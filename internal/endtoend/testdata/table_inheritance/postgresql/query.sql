@@ -0,0 +1,8 @@
+-- name: ListCapitals :many
+SELECT * FROM capitals;
+
+-- name: ListLandmarks :many
+SELECT * FROM landmarks;
+
+-- name: CapitalsInCountry :many
+SELECT name, state FROM capitals WHERE country = $1;
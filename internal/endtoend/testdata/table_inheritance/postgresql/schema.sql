@@ -0,0 +1,23 @@
+CREATE TABLE cities (
+    name       TEXT NOT NULL,
+    population REAL,
+    elevation  INT
+);
+
+CREATE TABLE capitals (
+    state CHAR(2) NOT NULL
+) INHERITS (cities);
+
+CREATE TABLE tagged (
+    tags TEXT[] NOT NULL
+);
+
+CREATE TABLE landmarks (
+    name TEXT NOT NULL,
+    built INT
+) INHERITS (cities, tagged);
+
+-- Columns added to a parent are added to its children
+ALTER TABLE cities ADD COLUMN country TEXT NOT NULL;
+ALTER TABLE cities RENAME COLUMN elevation TO altitude;
+ALTER TABLE cities DROP COLUMN population;
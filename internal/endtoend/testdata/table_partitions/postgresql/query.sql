@@ -0,0 +1,14 @@
+-- name: ListMeasurements :many
+SELECT * FROM measurements WHERE logdate >= $1;
+
+-- name: ListJanuary :many
+SELECT * FROM measurements_2024_01;
+
+-- name: ListFebruary :many
+SELECT * FROM measurements_2024_02;
+
+-- name: ListMarch :many
+SELECT * FROM measurements_2024_03;
+
+-- name: ListEuropeanOrders :many
+SELECT * FROM orders_eu_1;
@@ -0,0 +1,37 @@
+CREATE TABLE measurements (
+    city_id   INT NOT NULL,
+    logdate   DATE NOT NULL,
+    peaktemp  INT,
+    unitsales INT
+) PARTITION BY RANGE (logdate);
+
+CREATE TABLE measurements_2024_01 PARTITION OF measurements
+    FOR VALUES FROM ('2024-01-01') TO ('2024-02-01');
+
+CREATE TABLE measurements_2024_02 PARTITION OF measurements (
+    peaktemp NOT NULL
+) FOR VALUES FROM ('2024-02-01') TO ('2024-03-01');
+
+CREATE TABLE measurements_default PARTITION OF measurements DEFAULT;
+
+CREATE TABLE measurements_2024_03 (
+    city_id   INT NOT NULL,
+    logdate   DATE NOT NULL,
+    peaktemp  INT,
+    unitsales INT
+);
+ALTER TABLE measurements ATTACH PARTITION measurements_2024_03
+    FOR VALUES FROM ('2024-03-01') TO ('2024-04-01');
+
+ALTER TABLE measurements ADD COLUMN region TEXT;
+
+CREATE TABLE orders (
+    id     BIGINT NOT NULL,
+    region TEXT NOT NULL
+) PARTITION BY LIST (region);
+
+CREATE TABLE orders_eu PARTITION OF orders FOR VALUES IN ('eu')
+    PARTITION BY HASH (id);
+
+CREATE TABLE orders_eu_0 PARTITION OF orders_eu FOR VALUES WITH (MODULUS 2, REMAINDER 0);
+CREATE TABLE orders_eu_1 PARTITION OF orders_eu FOR VALUES WITH (MODULUS 2, REMAINDER 1);
@@ -0,0 +1,11 @@
+-- name: Upsert :exec
+INSERT INTO foo (id, name) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE name = VALUES(name), count = count + ?;
+
+-- name: UpsertParam :exec
+INSERT INTO foo (id, name) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE note = ?;
+
+-- name: UpsertValues :exec
+INSERT INTO foo (id, name) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE count = ? + VALUES(count);
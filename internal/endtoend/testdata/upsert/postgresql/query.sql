@@ -0,0 +1,16 @@
+-- name: Upsert :exec
+INSERT INTO foo (id, name) VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, count = foo.count + $3;
+
+-- name: UpsertWhere :exec
+INSERT INTO foo (id, name) VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE SET note = $3 WHERE foo.count < $4;
+
+-- name: UpsertExcluded :exec
+INSERT INTO foo (id, name, note) VALUES ($1, $2, $3)
+ON CONFLICT (name) DO UPDATE SET count = $4 + excluded.count WHERE excluded.note = $5;
+
+-- name: UpsertConstraint :one
+INSERT INTO foo (id, name) VALUES ($1, $2)
+ON CONFLICT ON CONSTRAINT foo_pkey DO NOTHING
+RETURNING *;
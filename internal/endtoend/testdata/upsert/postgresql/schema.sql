@@ -0,0 +1,7 @@
+CREATE TABLE foo (
+    id bigint PRIMARY KEY,
+    name text NOT NULL,
+    count int NOT NULL DEFAULT 0,
+    note text,
+    UNIQUE (name)
+);
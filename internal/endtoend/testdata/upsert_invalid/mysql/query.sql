@@ -0,0 +1,7 @@
+-- name: MissingColumn :exec
+INSERT INTO foo (id, name) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE missing = 1;
+
+-- name: MissingValues :exec
+INSERT INTO foo (id, name) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE name = VALUES(missing);
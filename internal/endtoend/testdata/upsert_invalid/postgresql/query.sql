@@ -0,0 +1,15 @@
+-- name: MissingTarget :exec
+INSERT INTO foo (id, name) VALUES ($1, $2)
+ON CONFLICT (missing) DO NOTHING;
+
+-- name: MissingConstraint :exec
+INSERT INTO foo (id, name) VALUES ($1, $2)
+ON CONFLICT ON CONSTRAINT foo_missing_key DO NOTHING;
+
+-- name: MissingColumn :exec
+INSERT INTO foo (id, name) VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE SET missing = 1;
+
+-- name: MissingExcluded :exec
+INSERT INTO foo (id, name) VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE SET name = excluded.missing;
@@ -1,6 +1,10 @@
 package dolphin
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 )
 
@@ -9,8 +13,35 @@ func NewCatalog() *catalog.Catalog {
 	return &catalog.Catalog{
 		DefaultSchema: def,
 		Schemas: []*catalog.Schema{
-			defaultSchema(def),
+			setFuncNullability(defaultSchema(def)),
 		},
-		Extensions: map[string]struct{}{},
+		Extensions:     map[string]struct{}{},
+		NameConstraint: constraintName,
+	}
+}
+
+// constraintName names foreign keys and checks the way MySQL does, numbering
+// them after the highest number that's already in use for the table. Keys are
+// named after their index by the parser.
+func constraintName(tbl *catalog.Table, con *catalog.Constraint) string {
+	var kind string
+	switch con.Type {
+	case catalog.ConstraintForeignKey:
+		kind = "ibfk"
+	case catalog.ConstraintCheck:
+		kind = "chk"
+	default:
+		return ""
+	}
+	prefix := fmt.Sprintf("%s_%s_", tbl.Rel.Name, kind)
+	var last int
+	for _, c := range tbl.Constraints {
+		if !strings.HasPrefix(c.Name, prefix) {
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimPrefix(c.Name, prefix)); err == nil && n > last {
+			last = n
+		}
 	}
+	return fmt.Sprintf("%s%d", prefix, last+1)
 }
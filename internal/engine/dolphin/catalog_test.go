@@ -0,0 +1,222 @@
+package dolphin
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestUpdateConstraints(t *testing.T) {
+	p := NewParser()
+	for i, tc := range []struct {
+		stmt        string
+		constraints map[string][]*catalog.Constraint
+		indexes     []*catalog.Index
+	}{
+		{
+			`
+			CREATE TABLE authors (
+			  id int NOT NULL PRIMARY KEY,
+			  name text NOT NULL,
+			  UNIQUE KEY (name)
+			);
+			CREATE TABLE books (
+			  id int NOT NULL,
+			  author_id int,
+			  PRIMARY KEY (id),
+			  KEY books_author (author_id),
+			  FOREIGN KEY (author_id) REFERENCES authors (id) ON DELETE CASCADE
+			);
+			`,
+			map[string][]*catalog.Constraint{
+				"authors": {
+					{Name: "PRIMARY", Type: catalog.ConstraintPrimaryKey, Columns: []string{"id"}},
+					{Name: "name", Type: catalog.ConstraintUnique, Columns: []string{"name"}},
+				},
+				"books": {
+					{Name: "PRIMARY", Type: catalog.ConstraintPrimaryKey, Columns: []string{"id"}},
+					{
+						Name:       "books_ibfk_1",
+						Type:       catalog.ConstraintForeignKey,
+						Columns:    []string{"author_id"},
+						RefTable:   &ast.TableName{Name: "authors"},
+						RefColumns: []string{"id"},
+						OnDelete:   catalog.ActionCascade,
+					},
+				},
+			},
+			[]*catalog.Index{
+				{Name: "books_author", Table: &ast.TableName{Name: "books"}, Columns: []string{"author_id"}},
+			},
+		},
+		{
+			`
+			CREATE TABLE authors (id int NOT NULL PRIMARY KEY);
+			CREATE TABLE books (
+			  id int NOT NULL,
+			  author_id int,
+			  editor_id int,
+			  CONSTRAINT books_author FOREIGN KEY (author_id) REFERENCES authors (id)
+			);
+			ALTER TABLE books ADD FOREIGN KEY (editor_id) REFERENCES authors (id) ON DELETE SET NULL;
+			ALTER TABLE books ADD FOREIGN KEY (author_id) REFERENCES authors (id);
+			ALTER TABLE books DROP FOREIGN KEY books_ibfk_1;
+			`,
+			map[string][]*catalog.Constraint{
+				"authors": {
+					{Name: "PRIMARY", Type: catalog.ConstraintPrimaryKey, Columns: []string{"id"}},
+				},
+				"books": {
+					{
+						Name:       "books_author",
+						Type:       catalog.ConstraintForeignKey,
+						Columns:    []string{"author_id"},
+						RefTable:   &ast.TableName{Name: "authors"},
+						RefColumns: []string{"id"},
+					},
+					{
+						Name:       "books_ibfk_2",
+						Type:       catalog.ConstraintForeignKey,
+						Columns:    []string{"author_id"},
+						RefTable:   &ast.TableName{Name: "authors"},
+						RefColumns: []string{"id"},
+					},
+				},
+			},
+			nil,
+		},
+		{
+			`
+			CREATE TABLE foo (bar int NOT NULL, baz text, qux text, UNIQUE KEY foo_baz (baz), KEY (qux));
+			ALTER TABLE foo ADD PRIMARY KEY (bar);
+			ALTER TABLE foo RENAME INDEX foo_baz TO foo_baz_unique;
+			ALTER TABLE foo RENAME INDEX qux TO foo_qux;
+			ALTER TABLE foo DROP PRIMARY KEY;
+			`,
+			map[string][]*catalog.Constraint{
+				"foo": {
+					{Name: "foo_baz_unique", Type: catalog.ConstraintUnique, Columns: []string{"baz"}},
+				},
+			},
+			[]*catalog.Index{
+				{Name: "foo_qux", Table: &ast.TableName{Name: "foo"}, Columns: []string{"qux"}},
+			},
+		},
+		{
+			`
+			CREATE TABLE foo (bar text, baz text, KEY (bar), KEY (baz));
+			DROP INDEX bar ON foo;
+			ALTER TABLE foo DROP COLUMN baz;
+			CREATE TABLE qux (bar text, KEY (bar));
+			DROP TABLE qux;
+			`,
+			map[string][]*catalog.Constraint{"foo": nil},
+			nil,
+		},
+	} {
+		test := tc
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			stmts, err := p.Parse(strings.NewReader(test.stmt))
+			if err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			c := NewCatalog()
+			if err := c.Build(stmts); err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			var schema *catalog.Schema
+			for _, s := range c.Schemas {
+				if s.Name == c.DefaultSchema {
+					schema = s
+				}
+			}
+			constraints := map[string][]*catalog.Constraint{}
+			for _, tbl := range schema.Tables {
+				constraints[tbl.Rel.Name] = tbl.Constraints
+			}
+			if diff := cmp.Diff(test.constraints, constraints, cmpopts.EquateEmpty()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("constraint mismatch:\n%s", diff)
+			}
+			if diff := cmp.Diff(test.indexes, schema.Indexes, cmpopts.EquateEmpty()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("index mismatch:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateErrors(t *testing.T) {
+	p := NewParser()
+	for i, tc := range []struct {
+		stmt string
+		err  *sqlerr.Error
+	}{
+		{
+			`
+			CREATE TABLE foo (bar int);
+			ALTER TABLE foo DROP FOREIGN KEY foo_ibfk_1;
+			`,
+			sqlerr.ConstraintNotFound("foo", "foo_ibfk_1"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar int);
+			ALTER TABLE foo DROP PRIMARY KEY;
+			`,
+			sqlerr.ConstraintNotFound("foo", "PRIMARY"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar int, KEY (bar));
+			ALTER TABLE foo DROP INDEX baz;
+			`,
+			sqlerr.IndexNotFound("baz"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar int, CONSTRAINT foo_bar UNIQUE KEY (bar));
+			ALTER TABLE foo ADD CONSTRAINT foo_bar CHECK (bar > 0);
+			`,
+			sqlerr.ConstraintExists("foo", "foo_bar"),
+		},
+	} {
+		test := tc
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			stmts, err := p.Parse(strings.NewReader(test.stmt))
+			if err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			c := NewCatalog()
+			err = c.Build(stmts)
+			if err == nil {
+				t.Log(test.stmt)
+				t.Fatal("err was nil")
+			}
+
+			var actual *sqlerr.Error
+			if !errors.As(err, &actual) {
+				t.Fatalf("err is not *sqlerr.Error: %#v", err)
+			}
+
+			if diff := cmp.Diff(test.err.Error(), actual.Error()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("error mismatch: \n%s", diff)
+			}
+		})
+	}
+}
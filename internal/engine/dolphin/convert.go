@@ -3,6 +3,7 @@ package dolphin
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	pcast "github.com/pingcap/parser/ast"
@@ -35,20 +36,18 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 		case pcast.AlterTableAddColumns:
 			for _, def := range spec.NewColumns {
 				name := def.Name.String()
-				columnDef := ast.ColumnDef{
-					Colname:   def.Name.String(),
-					TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
-					IsNotNull: isNotNull(def),
-				}
-				if def.Tp.Flen >= 0 {
-					length := def.Tp.Flen
-					columnDef.Length = &length
-				}
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
-					Name:    &name,
-					Subtype: ast.AT_AddColumn,
-					Def:     &columnDef,
+					Name:      &name,
+					Subtype:   ast.AT_AddColumn,
+					Def:       convertColumn(def),
+					MissingOk: spec.IfNotExists,
 				})
+				for _, con := range c.convertColumnConstraints(def) {
+					alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+						Subtype:    ast.AT_AddConstraint,
+						Constraint: con,
+					})
+				}
 			}
 
 		case pcast.AlterTableDropColumn:
@@ -60,36 +59,90 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			})
 
 		case pcast.AlterTableChangeColumn:
-			// 	spew.Dump("change column", spec)
+			old := spec.OldColumnName.Name.String()
+			for _, def := range spec.NewColumns {
+				alt.Cmds.Items = append(alt.Cmds.Items, modifyColumn(old, def)...)
+				if name := def.Name.Name.String(); name != old {
+					alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+						Name:    &old,
+						Subtype: ast.AT_RenameColumn,
+						Def:     &ast.ColumnDef{Colname: name},
+					})
+				}
+			}
 
 		case pcast.AlterTableModifyColumn:
 			for _, def := range spec.NewColumns {
-				name := def.Name.String()
-				columnDef := ast.ColumnDef{
-					Colname:   def.Name.String(),
-					TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
-					IsNotNull: isNotNull(def),
-				}
-				if def.Tp.Flen >= 0 {
-					length := def.Tp.Flen
-					columnDef.Length = &length
+				alt.Cmds.Items = append(alt.Cmds.Items, modifyColumn(def.Name.Name.String(), def)...)
+			}
+
+		case pcast.AlterTableAlterColumn:
+			for _, def := range spec.NewColumns {
+				name := def.Name.Name.String()
+				// DROP DEFAULT doesn't have any options
+				var expr string
+				if len(def.Options) > 0 {
+					expr = restoreExpr(def.Options[0].Expr)
 				}
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
-					Subtype: ast.AT_DropColumn,
+					Subtype: ast.AT_ColumnDefault,
+					Def:     &ast.ColumnDef{Colname: name, Default: expr},
 				})
+			}
+
+		case pcast.AlterTableAddConstraint:
+			con, idx := c.convertTableConstraint(n.Table, spec.Constraint)
+			if con != nil {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
-					Name:    &name,
-					Subtype: ast.AT_AddColumn,
-					Def:     &columnDef,
+					Subtype:    ast.AT_AddConstraint,
+					Constraint: con,
+				})
+			}
+			if idx != nil {
+				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+					Subtype: ast.AT_AddIndex,
+					Index:   idx,
 				})
 			}
 
-		case pcast.AlterTableAlterColumn:
-			// 	spew.Dump("alter column", spec)
+		case pcast.AlterTableDropPrimaryKey:
+			name := primaryKeyName
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:    &name,
+				Subtype: ast.AT_DropConstraint,
+			})
 
-		case pcast.AlterTableAddConstraint:
-			// 	spew.Dump("add const", spec)
+		case pcast.AlterTableDropForeignKey:
+			name := spec.Name
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:    &name,
+				Subtype: ast.AT_DropConstraint,
+			})
+
+		case pcast.AlterTableDropCheck:
+			name := spec.Constraint.Name
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:    &name,
+				Subtype: ast.AT_DropConstraint,
+			})
+
+		case pcast.AlterTableDropIndex:
+			name := spec.Name
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:      &name,
+				Subtype:   ast.AT_DropIndex,
+				MissingOk: spec.IfExists,
+			})
+
+		case pcast.AlterTableRenameIndex:
+			// TODO: Returning here may be incorrect if there are multiple specs
+			newName := spec.ToKey.String()
+			return &ast.RenameIndexStmt{
+				Index:   &ast.TableName{Name: spec.FromKey.String()},
+				NewName: &newName,
+				Table:   parseTableName(n.Table),
+			}
 
 		case pcast.AlterTableRenameColumn:
 			// TODO: Returning here may be incorrect if there are multiple specs
@@ -207,7 +260,170 @@ func (c *cc) convertBinaryOperationExpr(n *pcast.BinaryOperationExpr) ast.Node {
 	}
 }
 
+// convertColumn converts a column definition. Its constraints are converted
+// separately.
+func convertColumn(def *pcast.ColumnDef) *ast.ColumnDef {
+	var vals *ast.List
+	if len(def.Tp.Elems) > 0 {
+		vals = &ast.List{}
+		for i := range def.Tp.Elems {
+			vals.Items = append(vals.Items, &ast.String{
+				Str: def.Tp.Elems[i],
+			})
+		}
+	}
+	comment := ""
+	for _, opt := range def.Options {
+		switch opt.Tp {
+		case pcast.ColumnOptionComment:
+			if value, ok := opt.Expr.(*driver.ValueExpr); ok {
+				comment = value.GetString()
+			}
+		}
+	}
+	columnDef := &ast.ColumnDef{
+		Colname:   def.Name.String(),
+		TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
+		IsNotNull: isNotNull(def),
+		Comment:   comment,
+		Vals:      vals,
+	}
+	if def.Tp.Flen >= 0 {
+		length := def.Tp.Flen
+		columnDef.Length = &length
+	}
+	setColumnDefault(columnDef, def)
+	return columnDef
+}
+
+// modifyColumn replaces the type, nullability and default of a column, as
+// MODIFY and CHANGE COLUMN do
+func modifyColumn(name string, def *pcast.ColumnDef) []ast.Node {
+	col := convertColumn(def)
+	col.Colname = name
+	nullability := ast.AT_DropNotNull
+	if col.IsNotNull {
+		nullability = ast.AT_SetNotNull
+	}
+	return []ast.Node{
+		&ast.AlterTableCmd{Name: &name, Subtype: ast.AT_AlterColumnType, Def: col},
+		&ast.AlterTableCmd{Name: &name, Subtype: nullability},
+		&ast.AlterTableCmd{
+			Name:    &name,
+			Subtype: ast.AT_ColumnDefault,
+			Def:     &ast.ColumnDef{Colname: name, Default: col.Default},
+		},
+	}
+}
+
+// convertColumnConstraints returns the keys and checks declared on a column.
+// Like MySQL, inline REFERENCES clauses are ignored.
+func (c *cc) convertColumnConstraints(def *pcast.ColumnDef) []*ast.Constraint {
+	var constraints []*ast.Constraint
+	col := def.Name.Name.String()
+	keys := &ast.List{Items: []ast.Node{&ast.String{Str: col}}}
+	for _, opt := range def.Options {
+		switch opt.Tp {
+		case pcast.ColumnOptionPrimaryKey:
+			name := primaryKeyName
+			constraints = append(constraints, &ast.Constraint{
+				Contype: ast.ConstrTypePrimary,
+				Conname: &name,
+				Keys:    keys,
+			})
+		case pcast.ColumnOptionUniqKey:
+			name := col
+			constraints = append(constraints, &ast.Constraint{
+				Contype: ast.ConstrTypeUnique,
+				Conname: &name,
+				Keys:    keys,
+			})
+		case pcast.ColumnOptionCheck:
+			con := &ast.Constraint{
+				Contype: ast.ConstrTypeCheck,
+				RawExpr: c.convert(opt.Expr),
+			}
+			if opt.ConstraintName != "" {
+				name := opt.ConstraintName
+				con.Conname = &name
+			}
+			constraints = append(constraints, con)
+		}
+	}
+	return constraints
+}
+
+// convertTableConstraint converts a table constraint. MySQL creates plain indexes
+// for keys that aren't unique, so those are returned as index statements.
+func (c *cc) convertTableConstraint(table *pcast.TableName, n *pcast.Constraint) (*ast.Constraint, *ast.IndexStmt) {
+	switch n.Tp {
+	case pcast.ConstraintPrimaryKey:
+		name := primaryKeyName
+		return &ast.Constraint{
+			Contype: ast.ConstrTypePrimary,
+			Conname: &name,
+			Keys:    indexKeys(n.Keys),
+		}, nil
+
+	case pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex:
+		return &ast.Constraint{
+			Contype: ast.ConstrTypeUnique,
+			Conname: indexName(n.Name, n.Keys),
+			Keys:    indexKeys(n.Keys),
+		}, nil
+
+	case pcast.ConstraintKey, pcast.ConstraintIndex, pcast.ConstraintFulltext:
+		return nil, &ast.IndexStmt{
+			Idxname:     indexName(n.Name, n.Keys),
+			Relation:    c.convertTableName(table),
+			IndexParams: indexParams(n.Keys),
+		}
+
+	case pcast.ConstraintForeignKey:
+		con := &ast.Constraint{
+			Contype: ast.ConstrTypeForeign,
+			FkAttrs: indexKeys(n.Keys),
+		}
+		if n.Name != "" {
+			name := n.Name
+			con.Conname = &name
+		}
+		if n.Refer != nil {
+			con.Pktable = c.convertTableName(n.Refer.Table)
+			con.PkAttrs = indexKeys(n.Refer.IndexPartSpecifications)
+			if n.Refer.OnDelete != nil {
+				con.FkDelAction = referAction(n.Refer.OnDelete.ReferOpt)
+			}
+			if n.Refer.OnUpdate != nil {
+				con.FkUpdAction = referAction(n.Refer.OnUpdate.ReferOpt)
+			}
+		}
+		return con, nil
+
+	case pcast.ConstraintCheck:
+		con := &ast.Constraint{
+			Contype: ast.ConstrTypeCheck,
+			RawExpr: c.convert(n.Expr),
+		}
+		if n.Name != "" {
+			name := n.Name
+			con.Conname = &name
+		}
+		return con, nil
+	}
+	return nil, nil
+}
+
 func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
+	if n.Select != nil && len(n.Cols) == 0 {
+		return &ast.CreateTableAsStmt{
+			Query: c.convert(n.Select),
+			Into: &ast.IntoClause{
+				Rel: c.convertTableName(n.Table),
+			},
+			IfNotExists: n.IfNotExists,
+		}
+	}
 	create := &ast.CreateTableStmt{
 		Name:        parseTableName(n.Table),
 		IfNotExists: n.IfNotExists,
@@ -216,36 +432,17 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 		create.ReferTable = parseTableName(n.ReferTable)
 	}
 	for _, def := range n.Cols {
-		var vals *ast.List
-		if len(def.Tp.Elems) > 0 {
-			vals = &ast.List{}
-			for i := range def.Tp.Elems {
-				vals.Items = append(vals.Items, &ast.String{
-					Str: def.Tp.Elems[i],
-				})
-			}
-		}
-		comment := ""
-		for _, opt := range def.Options {
-			switch opt.Tp {
-			case pcast.ColumnOptionComment:
-				if value, ok := opt.Expr.(*driver.ValueExpr); ok {
-					comment = value.GetString()
-				}
-			}
-		}
-		columnDef := ast.ColumnDef{
-			Colname:   def.Name.String(),
-			TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
-			IsNotNull: isNotNull(def),
-			Comment:   comment,
-			Vals:      vals,
+		create.Cols = append(create.Cols, convertColumn(def))
+		create.Constraints = append(create.Constraints, c.convertColumnConstraints(def)...)
+	}
+	for _, con := range n.Constraints {
+		constraint, idx := c.convertTableConstraint(n.Table, con)
+		if constraint != nil {
+			create.Constraints = append(create.Constraints, constraint)
 		}
-		if def.Tp.Flen >= 0 {
-			length := def.Tp.Flen
-			columnDef.Length = &length
+		if idx != nil {
+			create.Indexes = append(create.Indexes, idx)
 		}
-		create.Cols = append(create.Cols, &columnDef)
 	}
 	for _, opt := range n.Options {
 		switch opt.Tp {
@@ -302,10 +499,6 @@ func (c *cc) convertDeleteStmt(n *pcast.DeleteStmt) *ast.DeleteStmt {
 }
 
 func (c *cc) convertDropTableStmt(n *pcast.DropTableStmt) ast.Node {
-	// TODO: Remove once views are supported.
-	if n.IsView {
-		return todo(n)
-	}
 	drop := &ast.DropTableStmt{IfExists: n.IfExists}
 	for _, name := range n.Tables {
 		drop.Tables = append(drop.Tables, parseTableName(name))
@@ -398,9 +591,26 @@ func (c *cc) convertInsertStmt(n *pcast.InsertStmt) *ast.InsertStmt {
 			ValuesLists: c.convertLists(n.Lists),
 		}
 	}
+	if len(n.OnDuplicate) > 0 {
+		insert.OnConflictClause = c.convertOnDuplicate(n.OnDuplicate)
+	}
 	return insert
 }
 
+// convertOnDuplicate converts ON DUPLICATE KEY UPDATE into the equivalent of
+// ON CONFLICT DO UPDATE. VALUES(col) refers to the value that would have been
+// inserted, like excluded.col does in PostgreSQL.
+func (c *cc) convertOnDuplicate(list []*pcast.Assignment) *ast.OnConflictClause {
+	targets := &ast.List{}
+	for _, a := range list {
+		targets.Items = append(targets.Items, c.convertAssignment(a))
+	}
+	return &ast.OnConflictClause{
+		Action:     ast.ONCONFLICT_UPDATE,
+		TargetList: targets,
+	}
+}
+
 func (c *cc) convertLists(lists [][]pcast.ExprNode) *ast.List {
 	list := &ast.List{Items: []ast.Node{}}
 	for _, exprs := range lists {
@@ -475,15 +685,23 @@ func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
 	}
 
 	var rangeVar *ast.RangeVar
+	var quals ast.Node
+	from := &ast.List{}
 	switch rel := rels.Items[0].(type) {
 
-	// Special case for joins in updates
+	// Special case for joins in updates. Like UPDATE ... FROM in PostgreSQL,
+	// the joined table is part of the FROM clause and the join condition
+	// is part of the WHERE clause.
 	case *ast.JoinExpr:
 		left, ok := rel.Larg.(*ast.RangeVar)
 		if !ok {
 			panic("expected range var")
 		}
 		rangeVar = left
+		if right, ok := rel.Rarg.(*ast.RangeVar); ok {
+			from.Items = append(from.Items, right)
+		}
+		quals = rel.Quals
 
 	case *ast.RangeVar:
 		rangeVar = rel
@@ -497,16 +715,51 @@ func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
 	for _, a := range n.List {
 		list.Items = append(list.Items, c.convertAssignment(a))
 	}
+	where := c.convert(n.Where)
+	if quals != nil {
+		if n.Where == nil {
+			where = quals
+		} else {
+			where = &ast.BoolExpr{
+				Boolop: ast.BoolExprTypeAnd,
+				Args:   &ast.List{Items: []ast.Node{quals, where}},
+			}
+		}
+	}
 	return &ast.UpdateStmt{
 		Relation:      rangeVar,
 		TargetList:    list,
-		WhereClause:   c.convert(n.Where),
-		FromClause:    &ast.List{},
+		WhereClause:   where,
+		FromClause:    from,
 		ReturningList: &ast.List{},
 	}
 }
 
 func (c *cc) convertValueExpr(n *driver.ValueExpr) *ast.A_Const {
+	switch n.Datum.Kind() {
+	case driver.KindNull:
+		return &ast.A_Const{
+			Val: &ast.Null{},
+		}
+	case driver.KindInt64, driver.KindUint64:
+		return &ast.A_Const{
+			Val: &ast.Integer{
+				Ival: n.Datum.GetInt64(),
+			},
+		}
+	case driver.KindFloat32, driver.KindFloat64:
+		return &ast.A_Const{
+			Val: &ast.Float{
+				Str: strconv.FormatFloat(n.Datum.GetFloat64(), 'g', -1, 64),
+			},
+		}
+	case driver.KindMysqlDecimal:
+		return &ast.A_Const{
+			Val: &ast.Float{
+				Str: n.Datum.GetMysqlDecimal().String(),
+			},
+		}
+	}
 	return &ast.A_Const{
 		Val: &ast.String{
 			Str: n.Datum.GetString(),
@@ -592,7 +845,21 @@ func (c *cc) convertBeginStmt(n *pcast.BeginStmt) ast.Node {
 }
 
 func (c *cc) convertBetweenExpr(n *pcast.BetweenExpr) ast.Node {
-	return todo(n)
+	kind, name := ast.AEXPR_BETWEEN, "BETWEEN"
+	if n.Not {
+		kind, name = ast.AEXPR_NOT_BETWEEN, "NOT BETWEEN"
+	}
+	return &ast.A_Expr{
+		Kind:  kind,
+		Name:  &ast.List{Items: []ast.Node{&ast.String{Str: name}}},
+		Lexpr: c.convert(n.Expr),
+		Rexpr: &ast.List{
+			Items: []ast.Node{
+				c.convert(n.Left),
+				c.convert(n.Right),
+			},
+		},
+	}
 }
 
 func (c *cc) convertBinlogStmt(n *pcast.BinlogStmt) ast.Node {
@@ -604,7 +871,18 @@ func (c *cc) convertByItem(n *pcast.ByItem) ast.Node {
 }
 
 func (c *cc) convertCaseExpr(n *pcast.CaseExpr) ast.Node {
-	return todo(n)
+	if n == nil {
+		return nil
+	}
+	list := &ast.List{Items: []ast.Node{}}
+	for _, n := range n.WhenClauses {
+		list.Items = append(list.Items, c.convertWhenClause(n))
+	}
+	return &ast.CaseExpr{
+		Arg:       c.convert(n.Value),
+		Args:      list,
+		Defresult: c.convert(n.ElseClause),
+	}
 }
 
 func (c *cc) convertChangeStmt(n *pcast.ChangeStmt) ast.Node {
@@ -651,7 +929,14 @@ func (c *cc) convertCreateDatabaseStmt(n *pcast.CreateDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertCreateIndexStmt(n *pcast.CreateIndexStmt) ast.Node {
-	return todo(n)
+	name := n.IndexName
+	return &ast.IndexStmt{
+		Idxname:     &name,
+		Relation:    c.convertTableName(n.Table),
+		IndexParams: indexParams(n.IndexPartSpecifications),
+		Unique:      n.KeyType == pcast.IndexKeyTypeUnique,
+		IfNotExists: n.IfNotExists,
+	}
 }
 
 func (c *cc) convertCreateSequenceStmt(n *pcast.CreateSequenceStmt) ast.Node {
@@ -667,7 +952,19 @@ func (c *cc) convertCreateUserStmt(n *pcast.CreateUserStmt) ast.Node {
 }
 
 func (c *cc) convertCreateViewStmt(n *pcast.CreateViewStmt) ast.Node {
-	return todo(n)
+	var aliases *ast.List
+	if len(n.Cols) > 0 {
+		aliases = &ast.List{}
+		for _, col := range n.Cols {
+			aliases.Items = append(aliases.Items, &ast.String{Str: col.String()})
+		}
+	}
+	return &ast.ViewStmt{
+		View:    c.convertTableName(n.ViewName),
+		Aliases: aliases,
+		Query:   c.convert(n.Select),
+		Replace: n.OrReplace,
+	}
 }
 
 func (c *cc) convertDeallocateStmt(n *pcast.DeallocateStmt) ast.Node {
@@ -675,7 +972,11 @@ func (c *cc) convertDeallocateStmt(n *pcast.DeallocateStmt) ast.Node {
 }
 
 func (c *cc) convertDefaultExpr(n *pcast.DefaultExpr) ast.Node {
-	return todo(n)
+	// DEFAULT(col) refers to the default of another column
+	if n.Name != nil {
+		return todo(n)
+	}
+	return &ast.SetToDefault{}
 }
 
 func (c *cc) convertDeleteTableList(n *pcast.DeleteTableList) ast.Node {
@@ -700,7 +1001,11 @@ func (c *cc) convertDropDatabaseStmt(n *pcast.DropDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertDropIndexStmt(n *pcast.DropIndexStmt) ast.Node {
-	return todo(n)
+	return &ast.DropIndexStmt{
+		IfExists: n.IfExists,
+		Indexes:  []*ast.TableName{{Name: n.IndexName}},
+		Table:    parseTableName(n.Table),
+	}
 }
 
 func (c *cc) convertDropSequenceStmt(n *pcast.DropSequenceStmt) ast.Node {
@@ -784,7 +1089,14 @@ func (c *cc) convertIndexPartSpecification(n *pcast.IndexPartSpecification) ast.
 }
 
 func (c *cc) convertIsNullExpr(n *pcast.IsNullExpr) ast.Node {
-	return todo(n)
+	op := ast.NullTestTypeIsNull
+	if n.Not {
+		op = ast.NullTestTypeIsNotNull
+	}
+	return &ast.NullTest{
+		Arg:          c.convert(n.Expr),
+		Nulltesttype: op,
+	}
 }
 
 func (c *cc) convertIsTruthExpr(n *pcast.IsTruthExpr) ast.Node {
@@ -798,9 +1110,11 @@ func (c *cc) convertJoin(n *pcast.Join) *ast.List {
 	if n.Right != nil && n.Left != nil {
 		return &ast.List{
 			Items: []ast.Node{&ast.JoinExpr{
-				Larg:  c.convert(n.Left),
-				Rarg:  c.convert(n.Right),
-				Quals: c.convert(n.On),
+				Jointype:  convertJoinType(n),
+				IsNatural: n.NaturalJoin,
+				Larg:      c.convert(n.Left),
+				Rarg:      c.convert(n.Right),
+				Quals:     c.convert(n.On),
 			}},
 		}
 	}
@@ -873,7 +1187,31 @@ func (c *cc) convertPartitionByClause(n *pcast.PartitionByClause) ast.Node {
 }
 
 func (c *cc) convertPatternInExpr(n *pcast.PatternInExpr) ast.Node {
-	return todo(n)
+	if n.Sel != nil {
+		return &ast.SubLink{
+			SubLinkType: ast.ANY_SUBLINK,
+			Testexpr:    c.convert(n.Expr),
+			Subselect:   c.convert(n.Sel),
+		}
+	}
+	op := "="
+	if n.Not {
+		op = "<>"
+	}
+	list := &ast.List{}
+	for _, item := range n.List {
+		list.Items = append(list.Items, c.convert(item))
+	}
+	return &ast.A_Expr{
+		Kind: ast.AEXPR_IN,
+		Name: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: op},
+			},
+		},
+		Lexpr: c.convert(n.Expr),
+		Rexpr: list,
+	}
 }
 
 func (c *cc) convertPatternLikeExpr(n *pcast.PatternLikeExpr) ast.Node {
@@ -1126,7 +1464,27 @@ func (c *cc) convertTruncateTableStmt(n *pcast.TruncateTableStmt) *ast.TruncateS
 }
 
 func (c *cc) convertUnaryOperationExpr(n *pcast.UnaryOperationExpr) ast.Node {
-	return todo(n)
+	switch n.Op {
+	case opcode.Not, opcode.Not2:
+		return &ast.BoolExpr{
+			Boolop: ast.BoolExprTypeNot,
+			Args: &ast.List{
+				Items: []ast.Node{c.convert(n.V)},
+			},
+		}
+	case opcode.Minus:
+		return &ast.A_Expr{
+			Kind: ast.AEXPR_OP,
+			Name: &ast.List{
+				Items: []ast.Node{&ast.String{Str: "-"}},
+			},
+			Rexpr: c.convert(n.V),
+		}
+	case opcode.Plus:
+		return c.convert(n.V)
+	default:
+		return todo(n)
+	}
 }
 
 func (c *cc) convertUnlockTablesStmt(n *pcast.UnlockTablesStmt) ast.Node {
@@ -1138,7 +1496,18 @@ func (c *cc) convertUseStmt(n *pcast.UseStmt) ast.Node {
 }
 
 func (c *cc) convertValuesExpr(n *pcast.ValuesExpr) ast.Node {
-	return todo(n)
+	if n.Column == nil {
+		return todo(n)
+	}
+	return &ast.ColumnRef{
+		Fields: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: "excluded"},
+				&ast.String{Str: n.Column.Name.Name.String()},
+			},
+		},
+		Location: n.Column.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertVariableAssignment(n *pcast.VariableAssignment) ast.Node {
@@ -1150,11 +1519,34 @@ func (c *cc) convertVariableExpr(n *pcast.VariableExpr) ast.Node {
 }
 
 func (c *cc) convertWhenClause(n *pcast.WhenClause) ast.Node {
-	return todo(n)
+	if n == nil {
+		return nil
+	}
+	return &ast.CaseWhen{
+		Expr:   c.convert(n.Expr),
+		Result: c.convert(n.Result),
+	}
 }
 
 func (c *cc) convertWindowFuncExpr(n *pcast.WindowFuncExpr) ast.Node {
-	return todo(n)
+	name := strings.ToLower(n.F)
+	args := &ast.List{}
+	for _, arg := range n.Args {
+		args.Items = append(args.Items, c.convert(arg))
+	}
+	return &ast.FuncCall{
+		Func: &ast.FuncName{
+			Name: name,
+		},
+		Funcname: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: name},
+			},
+		},
+		Args:        args,
+		AggDistinct: n.Distinct,
+		Over:        &ast.WindowDef{},
+	}
 }
 
 func (c *cc) convertWindowSpec(n *pcast.WindowSpec) ast.Node {
@@ -0,0 +1,70 @@
+package dolphin
+
+import "github.com/kyleconroy/sqlc/internal/sql/catalog"
+
+// Almost every MySQL function returns NULL if any of its arguments is NULL,
+// so only the exceptions are listed here.
+var nonStrictFuncs = map[string]struct{}{
+	"CHARSET":      {},
+	"COALESCE":     {},
+	"COERCIBILITY": {},
+	"COLLATION":    {},
+	"CONCAT_WS":    {},
+	"COUNT":        {},
+	"CUME_DIST":    {},
+	"DENSE_RANK":   {},
+	"FIELD":        {},
+	"GROUPING":     {},
+	"IF":           {},
+	"IFNULL":       {},
+	"ISNULL":       {},
+	"JSON_ARRAY":   {},
+	"JSON_OBJECT":  {},
+	"MAKE_SET":     {},
+	"NTILE":        {},
+	"PERCENT_RANK": {},
+	"RANK":         {},
+	"ROW_NUMBER":   {},
+}
+
+// Functions that may return NULL even if none of their arguments are NULL.
+// Most of these are aggregates, which return NULL when there are no input
+// rows, and window functions that read other rows of the partition.
+var nullableFuncs = map[string]struct{}{
+	"ANY_VALUE":      {},
+	"AVG":            {},
+	"ELT":            {},
+	"FIRST_VALUE":    {},
+	"GROUP_CONCAT":   {},
+	"JSON_ARRAYAGG":  {},
+	"JSON_EXTRACT":   {},
+	"JSON_OBJECTAGG": {},
+	"JSON_SEARCH":    {},
+	"JSON_VALUE":     {},
+	"LAG":            {},
+	"LAST_VALUE":     {},
+	"LEAD":           {},
+	"MAX":            {},
+	"MIN":            {},
+	"NTH_VALUE":      {},
+	"NULLIF":         {},
+	"STD":            {},
+	"STDDEV":         {},
+	"STDDEV_POP":     {},
+	"STDDEV_SAMP":    {},
+	"STR_TO_DATE":    {},
+	"SUM":            {},
+	"VARIANCE":       {},
+	"VAR_POP":        {},
+	"VAR_SAMP":       {},
+}
+
+func setFuncNullability(s *catalog.Schema) *catalog.Schema {
+	for _, fn := range s.Funcs {
+		_, nonStrict := nonStrictFuncs[fn.Name]
+		_, nullable := nullableFuncs[fn.Name]
+		fn.Strict = !nonStrict
+		fn.ReturnTypeNullable = nullable
+	}
+	return s
+}
@@ -210,7 +210,7 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType: &ast.TypeName{Name: "decimal"},
 		},
 		{
 			Name: "BENCHMARK",
@@ -533,6 +533,11 @@ func defaultSchema(name string) *catalog.Schema {
 			Args:       []*catalog.Argument{},
 			ReturnType: &ast.TypeName{Name: "double precision"},
 		},
+		{
+			Name:       "CUME_DIST",
+			Args:       []*catalog.Argument{},
+			ReturnType: &ast.TypeName{Name: "double"},
+		},
 		{
 			Name:       "CURDATE",
 			Args:       []*catalog.Argument{},
@@ -749,6 +754,11 @@ func defaultSchema(name string) *catalog.Schema {
 			Args:       []*catalog.Argument{},
 			ReturnType: &ast.TypeName{Name: "int"},
 		},
+		{
+			Name:       "DENSE_RANK",
+			Args:       []*catalog.Argument{},
+			ReturnType: &ast.TypeName{Name: "bigint"},
+		},
 		{
 			Name: "DISTINCT",
 			Args: []*catalog.Argument{
@@ -2451,6 +2461,11 @@ func defaultSchema(name string) *catalog.Schema {
 			Args:       []*catalog.Argument{},
 			ReturnType: &ast.TypeName{Name: "double precision"},
 		},
+		{
+			Name:       "PERCENT_RANK",
+			Args:       []*catalog.Argument{},
+			ReturnType: &ast.TypeName{Name: "double"},
+		},
 		{
 			Name: "PERIOD_ADD",
 			Args: []*catalog.Argument{
@@ -2610,6 +2625,11 @@ func defaultSchema(name string) *catalog.Schema {
 			Args:       []*catalog.Argument{},
 			ReturnType: &ast.TypeName{Name: "int"},
 		},
+		{
+			Name:       "RANK",
+			Args:       []*catalog.Argument{},
+			ReturnType: &ast.TypeName{Name: "bigint"},
+		},
 		{
 			Name: "REGEXP_INSTR",
 			Args: []*catalog.Argument{
@@ -2803,6 +2823,11 @@ func defaultSchema(name string) *catalog.Schema {
 			Args:       []*catalog.Argument{},
 			ReturnType: &ast.TypeName{Name: "int"},
 		},
+		{
+			Name:       "ROW_NUMBER",
+			Args:       []*catalog.Argument{},
+			ReturnType: &ast.TypeName{Name: "bigint"},
+		},
 		{
 			Name: "RPAD",
 			Args: []*catalog.Argument{
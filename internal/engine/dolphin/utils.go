@@ -1,7 +1,10 @@
 package dolphin
 
 import (
+	"strings"
+
 	pcast "github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
 
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 )
@@ -96,3 +99,101 @@ func isNotNull(n *pcast.ColumnDef) bool {
 	}
 	return false
 }
+
+func convertJoinType(n *pcast.Join) ast.JoinType {
+	switch n.Tp {
+	case pcast.LeftJoin:
+		return ast.JoinTypeLeft
+	case pcast.RightJoin:
+		return ast.JoinTypeRight
+	default:
+		return ast.JoinTypeInner
+	}
+}
+
+// MySQL always names the primary key PRIMARY
+const primaryKeyName = "PRIMARY"
+
+func indexKeys(parts []*pcast.IndexPartSpecification) *ast.List {
+	keys := &ast.List{}
+	for _, part := range parts {
+		if part.Column != nil {
+			keys.Items = append(keys.Items, &ast.String{Str: part.Column.Name.String()})
+		}
+	}
+	return keys
+}
+
+func indexParams(parts []*pcast.IndexPartSpecification) *ast.List {
+	params := &ast.List{}
+	for _, part := range parts {
+		elem := &ast.IndexElem{}
+		if part.Column != nil {
+			name := part.Column.Name.String()
+			elem.Name = &name
+		}
+		params.Items = append(params.Items, elem)
+	}
+	return params
+}
+
+// indexName returns the name of a key or index. Unnamed ones are named after
+// their first column, as MySQL does.
+func indexName(name string, parts []*pcast.IndexPartSpecification) *string {
+	if name == "" && len(parts) > 0 {
+		if parts[0].Column != nil {
+			name = parts[0].Column.Name.String()
+		} else {
+			name = "functional_index"
+		}
+	}
+	return &name
+}
+
+// referAction converts a foreign key action to the letters used by
+// ast.Constraint
+func referAction(opt pcast.ReferOptionType) byte {
+	switch opt {
+	case pcast.ReferOptionRestrict:
+		return 'r'
+	case pcast.ReferOptionCascade:
+		return 'c'
+	case pcast.ReferOptionSetNull:
+		return 'n'
+	case pcast.ReferOptionNoAction:
+		return 'a'
+	case pcast.ReferOptionSetDefault:
+		return 'd'
+	default:
+		return 0
+	}
+}
+
+// setColumnDefault records the default, AUTO_INCREMENT and generation
+// expression of a column. AUTO_INCREMENT columns are treated like identity
+// columns, since values can still be supplied explicitly.
+func setColumnDefault(def *ast.ColumnDef, n *pcast.ColumnDef) {
+	for _, opt := range n.Options {
+		switch opt.Tp {
+		case pcast.ColumnOptionDefaultValue:
+			def.Default = restoreExpr(opt.Expr)
+		case pcast.ColumnOptionAutoIncrement:
+			def.Identity = 'd'
+		case pcast.ColumnOptionGenerated:
+			if opt.Stored {
+				def.Generated = 's'
+			} else {
+				def.Generated = 'v'
+			}
+		}
+	}
+}
+
+// restoreExpr returns the SQL text of an expression
+func restoreExpr(n pcast.ExprNode) string {
+	var sb strings.Builder
+	if err := n.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return ""
+	}
+	return sb.String()
+}
@@ -5,8 +5,8 @@ import "github.com/kyleconroy/sqlc/internal/sql/catalog"
 func NewCatalog() *catalog.Catalog {
 	c := catalog.New("public")
 	c.Schemas = append(c.Schemas, pgTemp())
-	c.Schemas = append(c.Schemas, genPGCatalog())
-	c.SearchPath = []string{"pg_catalog"}
+	c.Schemas = append(c.Schemas, setFuncNullability(addTableFuncs(genPGCatalog())))
+	c.SystemSchema = "pg_catalog"
 	c.LoadExtension = loadExtension
 	return c
 }
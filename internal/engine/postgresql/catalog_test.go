@@ -6,9 +6,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestUpdateErrors(t *testing.T) {
@@ -140,6 +143,235 @@ func TestUpdateErrors(t *testing.T) {
 			`,
 			sqlerr.ColumnExists("foo", "baz"),
 		},
+		{
+			`
+			CREATE TABLE foo (bar text, CONSTRAINT foo_bar UNIQUE (bar));
+			ALTER TABLE foo ADD CONSTRAINT foo_bar CHECK (bar <> '');
+			`,
+			sqlerr.ConstraintExists("foo", "foo_bar"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar text, PRIMARY KEY (baz));
+			`,
+			sqlerr.ColumnNotFound("foo", "baz"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar text);
+			CREATE INDEX foo_baz ON foo (baz);
+			`,
+			sqlerr.ColumnNotFound("foo", "baz"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar text);
+			CREATE INDEX foo ON foo (bar);
+			`,
+			sqlerr.RelationExists("foo"),
+		},
+		{
+			`
+			DROP INDEX foo_bar;
+			`,
+			sqlerr.IndexNotFound("foo_bar"),
+		},
+		{
+			`
+			CREATE DOMAIN foo AS text;
+			CREATE TYPE foo AS ENUM ('bar');
+			`,
+			sqlerr.TypeExists("foo"),
+		},
+		{
+			`
+			ALTER DOMAIN foo SET NOT NULL;
+			`,
+			sqlerr.TypeNotFound("foo"),
+		},
+		{
+			`
+			CREATE TYPE foo AS (bar text);
+			ALTER TYPE foo ADD ATTRIBUTE bar int;
+			`,
+			sqlerr.ColumnExists("foo", "bar"),
+		},
+		{
+			`
+			CREATE TYPE foo AS (bar text);
+			ALTER TYPE foo RENAME ATTRIBUTE baz TO bat;
+			`,
+			sqlerr.ColumnNotFound("foo", "baz"),
+		},
+		{
+			`
+			CREATE SEQUENCE foo;
+			CREATE SEQUENCE foo;
+			`,
+			sqlerr.RelationExists("foo"),
+		},
+		{
+			`
+			CREATE TABLE foo (id serial);
+			CREATE SEQUENCE foo_id_seq;
+			`,
+			sqlerr.RelationExists("foo_id_seq"),
+		},
+		{
+			`
+			CREATE TABLE foo (id serial);
+			DROP TABLE foo;
+			DROP SEQUENCE foo_id_seq;
+			`,
+			sqlerr.SequenceNotFound("foo_id_seq"),
+		},
+		{
+			`
+			CREATE TABLE foo (id int);
+			CREATE SEQUENCE bar OWNED BY foo.bar;
+			`,
+			sqlerr.ColumnNotFound("foo", "bar"),
+		},
+		{
+			`
+			CREATE TABLE foo (id int);
+			CREATE TABLE bar (id text) INHERITS (foo);
+			`,
+			&sqlerr.Error{Message: `column "id" has a type conflict`},
+		},
+		{
+			`
+			CREATE TABLE foo (id int);
+			CREATE TABLE bar (id text);
+			CREATE TABLE baz () INHERITS (foo, bar);
+			`,
+			&sqlerr.Error{Message: `inherited column "id" has a type conflict`},
+		},
+		{
+			`
+			CREATE TABLE foo (id int);
+			CREATE TABLE bar PARTITION OF foo FOR VALUES IN (1);
+			`,
+			&sqlerr.Error{Message: `table "foo" is not partitioned`},
+		},
+		{
+			`
+			CREATE TABLE foo (id int) PARTITION BY LIST (id);
+			CREATE TABLE bar () INHERITS (foo);
+			`,
+			&sqlerr.Error{Message: `cannot inherit from partitioned table "foo"`},
+		},
+		{
+			`
+			CREATE TABLE foo (id int) PARTITION BY LIST (id);
+			CREATE TABLE bar PARTITION OF foo (name NOT NULL) FOR VALUES IN (1);
+			`,
+			sqlerr.ColumnNotFound("bar", "name"),
+		},
+		{
+			`
+			CREATE TABLE foo (id int, name text) PARTITION BY LIST (id);
+			CREATE TABLE bar (id int);
+			ALTER TABLE foo ATTACH PARTITION bar FOR VALUES IN (1);
+			`,
+			&sqlerr.Error{Message: `child table is missing column "name"`},
+		},
+		{
+			`
+			CREATE TABLE foo (id int);
+			CREATE TABLE bar (id int);
+			ALTER TABLE bar NO INHERIT foo;
+			`,
+			&sqlerr.Error{Message: `relation "foo" is not a parent of relation "bar"`},
+		},
+		{
+			`
+			CREATE TABLE foo (id int) PARTITION BY RANGE (id);
+			CREATE TABLE bar PARTITION OF foo FOR VALUES FROM (0) TO (10);
+			DROP TABLE foo;
+			ALTER TABLE bar ADD COLUMN id int;
+			`,
+			sqlerr.RelationNotFound("bar"),
+		},
+		{
+			`
+			CREATE TABLE foo (id int);
+			ALTER TABLE foo ADD COLUMN IF NOT EXISTS id int;
+			ALTER TABLE foo DROP COLUMN IF EXISTS missing;
+			ALTER TABLE foo ALTER COLUMN bar SET DEFAULT 1;
+			`,
+			sqlerr.ColumnNotFound("foo", "bar"),
+		},
+		{
+			`
+			CREATE TABLE foo (id int);
+			ALTER TABLE foo ALTER COLUMN id DROP IDENTITY;
+			`,
+			&sqlerr.Error{Message: `column "id" of relation "foo" is not an identity column`},
+		},
+		{
+			`
+			CREATE TABLE foo (id int);
+			ALTER TABLE foo ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY;
+			`,
+			&sqlerr.Error{Message: `column "id" of relation "foo" must be declared NOT NULL before identity can be added`},
+		},
+		{
+			`
+			CREATE TABLE foo (id int GENERATED ALWAYS AS IDENTITY);
+			ALTER TABLE foo ALTER COLUMN id ADD GENERATED BY DEFAULT AS IDENTITY;
+			`,
+			&sqlerr.Error{Message: `column "id" of relation "foo" is already an identity column`},
+		},
+		{
+			`
+			SET search_path = '';
+			CREATE TABLE foo (id int);
+			`,
+			&sqlerr.Error{Message: "no schema has been selected to create in"},
+		},
+		{
+			`
+			CREATE SCHEMA app;
+			SET search_path TO app;
+			CREATE TABLE foo (id int);
+			RESET search_path;
+			ALTER TABLE foo ADD COLUMN name text;
+			`,
+			sqlerr.RelationNotFound("foo"),
+		},
+		{
+			`
+			CREATE SCHEMA app;
+			SET search_path TO app;
+			CREATE TABLE foo (id int);
+			SET search_path TO public, app;
+			CREATE TABLE foo (id int);
+			CREATE TABLE app.foo (id int);
+			`,
+			sqlerr.RelationExists("foo"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar text, EXCLUDE (bar WITH =));
+			ALTER TABLE foo ADD CONSTRAINT foo_bar_excl CHECK (bar <> '');
+			`,
+			sqlerr.ConstraintExists("foo", "foo_bar_excl"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar text);
+			ALTER TABLE foo DROP CONSTRAINT foo_bar_key;
+			`,
+			sqlerr.ConstraintNotFound("foo", "foo_bar_key"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar text);
+			ALTER TABLE foo RENAME CONSTRAINT foo_bar_key TO foo_baz_key;
+			`,
+			sqlerr.ConstraintNotFound("foo", "foo_bar_key"),
+		},
 	} {
 		test := tc
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
@@ -168,3 +400,242 @@ func TestUpdateErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateConstraints(t *testing.T) {
+	p := NewParser()
+	for i, tc := range []struct {
+		stmt        string
+		constraints map[string][]*catalog.Constraint
+		indexes     []*catalog.Index
+	}{
+		{
+			`
+			CREATE TABLE authors (id int PRIMARY KEY, name text UNIQUE);
+			CREATE TABLE books (
+			  id int PRIMARY KEY,
+			  author_id int REFERENCES authors ON DELETE CASCADE,
+			  editor_id int,
+			  FOREIGN KEY (editor_id) REFERENCES authors (id) ON DELETE SET NULL ON UPDATE RESTRICT
+			);
+			`,
+			map[string][]*catalog.Constraint{
+				"authors": {
+					{Name: "authors_pkey", Type: catalog.ConstraintPrimaryKey, Columns: []string{"id"}},
+					{Name: "authors_name_key", Type: catalog.ConstraintUnique, Columns: []string{"name"}},
+				},
+				"books": {
+					{
+						Name:       "books_editor_id_fkey",
+						Type:       catalog.ConstraintForeignKey,
+						Columns:    []string{"editor_id"},
+						RefTable:   &ast.TableName{Name: "authors"},
+						RefColumns: []string{"id"},
+						OnDelete:   catalog.ActionSetNull,
+						OnUpdate:   catalog.ActionRestrict,
+					},
+					{Name: "books_pkey", Type: catalog.ConstraintPrimaryKey, Columns: []string{"id"}},
+					{
+						Name:       "books_author_id_fkey",
+						Type:       catalog.ConstraintForeignKey,
+						Columns:    []string{"author_id"},
+						RefTable:   &ast.TableName{Name: "authors"},
+						RefColumns: []string{"id"},
+						OnDelete:   catalog.ActionCascade,
+					},
+				},
+			},
+			nil,
+		},
+		{
+			`
+			CREATE TABLE foo (bar text, baz text);
+			CREATE INDEX ON foo (bar);
+			CREATE UNIQUE INDEX foo_baz ON foo (baz);
+			ALTER INDEX foo_baz RENAME TO foo_baz_unique;
+			ALTER TABLE foo RENAME bar TO qux;
+			`,
+			map[string][]*catalog.Constraint{"foo": nil},
+			[]*catalog.Index{
+				{Name: "foo_bar_idx", Table: &ast.TableName{Name: "foo"}, Columns: []string{"qux"}},
+				{Name: "foo_baz_unique", Table: &ast.TableName{Name: "foo"}, Columns: []string{"baz"}, Unique: true},
+			},
+		},
+		{
+			`
+			CREATE TABLE foo (bar text, baz text);
+			CREATE INDEX foo_bar ON foo (bar);
+			CREATE INDEX foo_baz ON foo (baz);
+			ALTER TABLE foo DROP COLUMN bar;
+			CREATE TABLE qux (bar text);
+			CREATE INDEX qux_bar ON qux (bar);
+			DROP TABLE qux;
+			`,
+			map[string][]*catalog.Constraint{"foo": nil},
+			[]*catalog.Index{
+				{Name: "foo_baz", Table: &ast.TableName{Name: "foo"}, Columns: []string{"baz"}},
+			},
+		},
+		{
+			`
+			CREATE TABLE foo (bar text UNIQUE, baz text CHECK (baz <> ''));
+			ALTER TABLE foo DROP CONSTRAINT foo_bar_key;
+			ALTER TABLE foo DROP CONSTRAINT IF EXISTS foo_bar_key;
+			ALTER TABLE foo RENAME CONSTRAINT foo_baz_check TO baz_not_empty;
+			`,
+			map[string][]*catalog.Constraint{
+				"foo": {
+					{Name: "baz_not_empty", Type: catalog.ConstraintCheck, Columns: []string{"baz"}},
+				},
+			},
+			nil,
+		},
+		{
+			`
+			CREATE TABLE a_table_name_that_is_long_enough_to_be_truncated (
+			  a_column_name_that_is_also_quite_long text UNIQUE
+			);
+			ALTER TABLE a_table_name_that_is_long_enough_to_be_truncated
+			  DROP CONSTRAINT a_table_name_that_is_long_eno_a_column_name_that_is_also_qu_key;
+			`,
+			map[string][]*catalog.Constraint{"a_table_name_that_is_long_enough_to_be_truncated": nil},
+			nil,
+		},
+	} {
+		test := tc
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			stmts, err := p.Parse(strings.NewReader(test.stmt))
+			if err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			c := NewCatalog()
+			if err := c.Build(stmts); err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			var schema *catalog.Schema
+			for _, s := range c.Schemas {
+				if s.Name == c.DefaultSchema {
+					schema = s
+				}
+			}
+			constraints := map[string][]*catalog.Constraint{}
+			for _, tbl := range schema.Tables {
+				constraints[tbl.Rel.Name] = tbl.Constraints
+			}
+			if diff := cmp.Diff(test.constraints, constraints, cmpopts.EquateEmpty()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("constraint mismatch:\n%s", diff)
+			}
+			if diff := cmp.Diff(test.indexes, schema.Indexes, cmpopts.EquateEmpty()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("index mismatch:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildViews(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE foo (bar text);
+		CREATE VIEW foo_view AS SELECT bar FROM foo;
+		CREATE TABLE foo_copy AS SELECT bar FROM foo;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without a column generator, the relations are created without columns
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"foo_view", "foo_copy"} {
+		tbl, err := c.GetTable(&ast.TableName{Name: name})
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if len(tbl.Columns) != 0 {
+			t.Errorf("%s: expected no columns, got %d", name, len(tbl.Columns))
+		}
+	}
+}
+
+func TestUpdateSequences(t *testing.T) {
+	p := NewParser()
+	for i, tc := range []struct {
+		stmt string
+		// The owner of each sequence, by schema and name
+		owners map[string]string
+	}{
+		{
+			`
+			CREATE TABLE foo (id serial);
+			ALTER TABLE foo RENAME COLUMN id TO foo_id;
+			`,
+			map[string]string{"public.foo_id_seq": "foo.foo_id"},
+		},
+		{
+			`
+			CREATE SCHEMA other;
+			CREATE TABLE foo (id serial);
+			ALTER TABLE foo SET SCHEMA other;
+			`,
+			map[string]string{"other.foo_id_seq": "other.foo.id"},
+		},
+		{
+			`
+			CREATE SCHEMA other;
+			CREATE TABLE foo (id serial);
+			ALTER TABLE foo SET SCHEMA other;
+			ALTER TABLE other.foo RENAME COLUMN id TO foo_id;
+			`,
+			map[string]string{"other.foo_id_seq": "other.foo.foo_id"},
+		},
+		{
+			`
+			CREATE SCHEMA other;
+			CREATE TABLE foo (id serial);
+			ALTER TABLE foo SET SCHEMA other;
+			ALTER TABLE other.foo DROP COLUMN id;
+			`,
+			map[string]string{},
+		},
+	} {
+		test := tc
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			stmts, err := p.Parse(strings.NewReader(test.stmt))
+			if err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			c := NewCatalog()
+			if err := c.Build(stmts); err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			owners := map[string]string{}
+			for _, s := range c.Schemas {
+				for _, seq := range s.Sequences {
+					if seq.OwnedBy == nil {
+						continue
+					}
+					owner := seq.OwnedBy.Name + "." + seq.OwnedByColumn
+					if seq.OwnedBy.Schema != "" {
+						owner = seq.OwnedBy.Schema + "." + owner
+					}
+					owners[s.Name+"."+seq.Name] = owner
+				}
+			}
+			if diff := cmp.Diff(test.owners, owners); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("sequence mismatch:\n%s", diff)
+			}
+		})
+	}
+}
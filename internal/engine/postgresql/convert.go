@@ -38,7 +38,7 @@ func convertSubLinkType(t pg.SubLinkType) (ast.SubLinkType, error) {
 	case pg.SubLinkType_ROWCOMPARE_SUBLINK:
 		return ast.ROWCOMPARE_SUBLINK, nil
 	case pg.SubLinkType_EXPR_SUBLINK:
-		return ast.EXISTS_SUBLINK, nil
+		return ast.EXPR_SUBLINK, nil
 	case pg.SubLinkType_MULTIEXPR_SUBLINK:
 		return ast.MULTIEXPR_SUBLINK, nil
 	case pg.SubLinkType_ARRAY_SUBLINK:
@@ -653,6 +653,15 @@ func convertCaseExpr(n *pg.CaseExpr) *ast.CaseExpr {
 	}
 }
 
+func convertCallStmt(n *pg.CallStmt) *ast.CallStmt {
+	if n == nil {
+		return nil
+	}
+	return &ast.CallStmt{
+		FuncCall: convertFuncCall(n.Funccall),
+	}
+}
+
 func convertCaseTestExpr(n *pg.CaseTestExpr) *ast.CaseTestExpr {
 	if n == nil {
 		return nil
@@ -796,6 +805,7 @@ func convertColumnDef(n *pg.ColumnDef) *ast.ColumnDef {
 		RawDefault:    convertNode(n.RawDefault),
 		CookedDefault: convertNode(n.CookedDefault),
 		Identity:      makeByte(n.Identity),
+		Generated:     makeByte(n.Generated),
 		CollClause:    convertCollateClause(n.CollClause),
 		CollOid:       ast.Oid(n.CollOid),
 		Constraints:   convertSlice(n.Constraints),
@@ -2027,6 +2037,9 @@ func convertPartitionBoundSpec(n *pg.PartitionBoundSpec) *ast.PartitionBoundSpec
 	}
 	return &ast.PartitionBoundSpec{
 		Strategy:    makeByte(n.Strategy),
+		IsDefault:   n.IsDefault,
+		Modulus:     int(n.Modulus),
+		Remainder:   int(n.Remainder),
 		Listdatums:  convertSlice(n.Listdatums),
 		Lowerdatums: convertSlice(n.Lowerdatums),
 		Upperdatums: convertSlice(n.Upperdatums),
@@ -3084,6 +3097,9 @@ func convertNode(node *pg.Node) ast.Node {
 	case *pg.Node_BooleanTest:
 		return convertBooleanTest(n.BooleanTest)
 
+	case *pg.Node_CallStmt:
+		return convertCallStmt(n.CallStmt)
+
 	case *pg.Node_CaseExpr:
 		return convertCaseExpr(n.CaseExpr)
 
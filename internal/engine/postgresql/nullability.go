@@ -0,0 +1,91 @@
+package postgresql
+
+import "github.com/kyleconroy/sqlc/internal/sql/catalog"
+
+// The generated pg_catalog doesn't record how functions treat NULL. Almost
+// every builtin function is strict (proisstrict), so only the exceptions are
+// listed here.
+var nonStrictFuncs = map[string]struct{}{
+	"array_append":       {},
+	"array_cat":          {},
+	"array_prepend":      {},
+	"concat":             {},
+	"concat_ws":          {},
+	"count":              {},
+	"cume_dist":          {},
+	"dense_rank":         {},
+	"format":             {},
+	"json_build_array":   {},
+	"json_build_object":  {},
+	"jsonb_build_array":  {},
+	"jsonb_build_object": {},
+	"ntile":              {},
+	"num_nonnulls":       {},
+	"num_nulls":          {},
+	"percent_rank":       {},
+	"rank":               {},
+	"regr_count":         {},
+	"row_number":         {},
+}
+
+// Functions that may return NULL even if none of their arguments are NULL.
+// Most of these are aggregates, which return NULL when there are no input
+// rows, and window functions that read other rows of the partition.
+var nullableFuncs = map[string]struct{}{
+	"array_agg":        {},
+	"array_length":     {},
+	"array_lower":      {},
+	"array_position":   {},
+	"array_upper":      {},
+	"avg":              {},
+	"bit_and":          {},
+	"bit_or":           {},
+	"bool_and":         {},
+	"bool_or":          {},
+	"corr":             {},
+	"covar_pop":        {},
+	"covar_samp":       {},
+	"every":            {},
+	"first_value":      {},
+	"json_agg":         {},
+	"json_object_agg":  {},
+	"jsonb_agg":        {},
+	"jsonb_object_agg": {},
+	"lag":              {},
+	"last_value":       {},
+	"lead":             {},
+	"max":              {},
+	"min":              {},
+	"mode":             {},
+	"nth_value":        {},
+	"percentile_cont":  {},
+	"percentile_disc":  {},
+	"regexp_match":     {},
+	"regr_avgx":        {},
+	"regr_avgy":        {},
+	"regr_intercept":   {},
+	"regr_r2":          {},
+	"regr_slope":       {},
+	"regr_sxx":         {},
+	"regr_sxy":         {},
+	"regr_syy":         {},
+	"stddev":           {},
+	"stddev_pop":       {},
+	"stddev_samp":      {},
+	"string_agg":       {},
+	"sum":              {},
+	"var_pop":          {},
+	"var_samp":         {},
+	"variance":         {},
+	"xmlagg":           {},
+}
+
+func setFuncNullability(s *catalog.Schema) *catalog.Schema {
+	for _, fn := range s.Funcs {
+		_, nonStrict := nonStrictFuncs[fn.Name]
+		_, nullable := nullableFuncs[fn.Name]
+		fn.Strict = !nonStrict
+		fn.ReturnTypeNullable = nullable
+	}
+	return s
+}
@@ -192,9 +192,99 @@ func (p *Parser) CommentSyntax() metadata.CommentSyntax {
 	}
 }
 
+// columnConstraints converts the constraints declared on a column into table
+// constraints on that column. NOT NULL and the like are stored on the column
+// itself, so they are skipped.
+func columnConstraints(n *nodes.ColumnDef) []*ast.Constraint {
+	var constraints []*ast.Constraint
+	for _, c := range n.Constraints {
+		inner, ok := c.Node.(*nodes.Node_Constraint)
+		if !ok {
+			continue
+		}
+		con := convertConstraint(inner.Constraint)
+		keys := &ast.List{Items: []ast.Node{&ast.String{Str: n.Colname}}}
+		switch con.Contype {
+		case ast.ConstrTypePrimary, ast.ConstrTypeUnique, ast.ConstrTypeCheck:
+			con.Keys = keys
+		case ast.ConstrTypeForeign:
+			con.FkAttrs = keys
+		default:
+			continue
+		}
+		constraints = append(constraints, con)
+	}
+	return constraints
+}
+
+// deparseExpr returns the SQL text of an expression
+func deparseExpr(n *nodes.Node) (string, error) {
+	tree := &nodes.ParseResult{
+		Stmts: []*nodes.RawStmt{
+			{
+				Stmt: &nodes.Node{
+					Node: &nodes.Node_SelectStmt{
+						SelectStmt: &nodes.SelectStmt{
+							TargetList: []*nodes.Node{
+								{
+									Node: &nodes.Node_ResTarget{
+										ResTarget: &nodes.ResTarget{Val: n},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	sql, err := nodes.Deparse(tree)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(sql, "SELECT "), nil
+}
+
+// setColumnDefault records the default, identity and generation expression of
+// a column
+func setColumnDefault(def *ast.ColumnDef, n *nodes.ColumnDef) error {
+	for _, c := range n.Constraints {
+		inner, ok := c.Node.(*nodes.Node_Constraint)
+		if !ok {
+			continue
+		}
+		con := inner.Constraint
+		switch con.Contype {
+		case nodes.ConstrType_CONSTR_DEFAULT:
+			expr, err := deparseExpr(con.RawExpr)
+			if err != nil {
+				return err
+			}
+			def.Default = expr
+		case nodes.ConstrType_CONSTR_IDENTITY:
+			def.Identity = makeByte(con.GeneratedWhen)
+		case nodes.ConstrType_CONSTR_GENERATED:
+			def.Generated = 's'
+		}
+	}
+	return nil
+}
+
 func translate(node *nodes.Node) (ast.Node, error) {
 	switch inner := node.Node.(type) {
 
+	case *nodes.Node_AlterDomainStmt:
+		n := inner.AlterDomainStmt
+		stmt := convertAlterDomainStmt(n)
+		if n.Subtype == "T" && n.Def != nil {
+			expr, err := deparseExpr(n.Def)
+			if err != nil {
+				return nil, err
+			}
+			stmt.Default = expr
+		}
+		return stmt, nil
+
 	case *nodes.Node_AlterEnumStmt:
 		n := inner.AlterEnumStmt
 		rel, err := parseRelationFromNodes(n.TypeName)
@@ -259,6 +349,17 @@ func translate(node *nodes.Node) (ast.Node, error) {
 						IsNotNull: isNotNull(d.ColumnDef),
 						IsArray:   isArray(d.ColumnDef.TypeName),
 					}
+					if err := setColumnDefault(item.Def, d.ColumnDef); err != nil {
+						return nil, err
+					}
+					at.Cmds.Items = append(at.Cmds.Items, item)
+					for _, con := range columnConstraints(d.ColumnDef) {
+						at.Cmds.Items = append(at.Cmds.Items, &ast.AlterTableCmd{
+							Subtype:    ast.AT_AddConstraint,
+							Constraint: con,
+						})
+					}
+					continue
 
 				case nodes.AlterTableType_AT_AlterColumnType:
 					d, ok := altercmd.Def.Node.(*nodes.Node_ColumnDef)
@@ -294,6 +395,69 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				case nodes.AlterTableType_AT_SetNotNull:
 					item.Subtype = ast.AT_SetNotNull
 
+				case nodes.AlterTableType_AT_ColumnDefault:
+					item.Subtype = ast.AT_ColumnDefault
+					item.Def = &ast.ColumnDef{Colname: altercmd.Name}
+					// DROP DEFAULT doesn't have an expression
+					if altercmd.Def != nil {
+						expr, err := deparseExpr(altercmd.Def)
+						if err != nil {
+							return nil, err
+						}
+						item.Def.Default = expr
+					}
+
+				case nodes.AlterTableType_AT_AddIdentity:
+					d, ok := altercmd.Def.Node.(*nodes.Node_Constraint)
+					if !ok {
+						return nil, fmt.Errorf("expected alter table defintion to be a Constraint")
+					}
+					item.Subtype = ast.AT_AddIdentity
+					item.Def = &ast.ColumnDef{
+						Colname:  altercmd.Name,
+						Identity: makeByte(d.Constraint.GeneratedWhen),
+					}
+
+				case nodes.AlterTableType_AT_DropIdentity:
+					item.Subtype = ast.AT_DropIdentity
+
+				case nodes.AlterTableType_AT_DropExpression:
+					item.Subtype = ast.AT_DropExpression
+
+				case nodes.AlterTableType_AT_AddConstraint:
+					d, ok := altercmd.Def.Node.(*nodes.Node_Constraint)
+					if !ok {
+						return nil, fmt.Errorf("expected alter table defintion to be a Constraint")
+					}
+					item.Subtype = ast.AT_AddConstraint
+					item.Constraint = convertConstraint(d.Constraint)
+
+				case nodes.AlterTableType_AT_DropConstraint:
+					item.Subtype = ast.AT_DropConstraint
+
+				case nodes.AlterTableType_AT_AttachPartition, nodes.AlterTableType_AT_DetachPartition:
+					d, ok := altercmd.Def.Node.(*nodes.Node_PartitionCmd)
+					if !ok {
+						return nil, fmt.Errorf("expected alter table defintion to be a PartitionCmd")
+					}
+					item.Subtype = ast.AT_AttachPartition
+					if altercmd.Subtype == nodes.AlterTableType_AT_DetachPartition {
+						item.Subtype = ast.AT_DetachPartition
+					}
+					item.Relation = parseRelationFromRangeVar(d.PartitionCmd.Name).TableName()
+					item.PartitionBound = convertPartitionBoundSpec(d.PartitionCmd.Bound)
+
+				case nodes.AlterTableType_AT_AddInherit, nodes.AlterTableType_AT_DropInherit:
+					d, ok := altercmd.Def.Node.(*nodes.Node_RangeVar)
+					if !ok {
+						return nil, fmt.Errorf("expected alter table defintion to be a RangeVar")
+					}
+					item.Subtype = ast.AT_AddInherit
+					if altercmd.Subtype == nodes.AlterTableType_AT_DropInherit {
+						item.Subtype = ast.AT_DropInherit
+					}
+					item.Relation = parseRelationFromRangeVar(d.RangeVar).TableName()
+
 				default:
 					continue
 				}
@@ -301,6 +465,13 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				at.Cmds.Items = append(at.Cmds.Items, item)
 			}
 		}
+		// ALTER TYPE ... ADD/DROP/ALTER ATTRIBUTE is parsed as an ALTER TABLE
+		if n.Relkind == nodes.ObjectType_OBJECT_TYPE {
+			return &ast.AlterTypeAttributesStmt{
+				Type: rel.TypeName(),
+				Cmds: at.Cmds,
+			}, nil
+		}
 		return at, nil
 
 	case *nodes.Node_CommentStmt:
@@ -338,7 +509,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				Comment: makeString(n.Comment),
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, err
@@ -354,21 +525,75 @@ func translate(node *nodes.Node) (ast.Node, error) {
 	case *nodes.Node_CompositeTypeStmt:
 		n := inner.CompositeTypeStmt
 		rel := parseRelationFromRangeVar(n.Typevar)
-		return &ast.CompositeTypeStmt{
+		stmt := &ast.CompositeTypeStmt{
 			TypeName: rel.TypeName(),
-		}, nil
+		}
+		for _, elt := range n.Coldeflist {
+			item, ok := elt.Node.(*nodes.Node_ColumnDef)
+			if !ok {
+				continue
+			}
+			rel, err := parseRelationFromNodes(item.ColumnDef.TypeName.Names)
+			if err != nil {
+				return nil, err
+			}
+			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+				Colname:  item.ColumnDef.Colname,
+				TypeName: rel.TypeName(),
+				IsArray:  isArray(item.ColumnDef.TypeName),
+			})
+		}
+		return stmt, nil
+
+	case *nodes.Node_CreateDomainStmt:
+		n := inner.CreateDomainStmt
+		rel, err := parseRelationFromNodes(n.TypeName.Names)
+		if err != nil {
+			return nil, err
+		}
+		stmt := &ast.CreateDomainStmt{
+			Domainname:  convertSlice(n.Domainname),
+			TypeName:    rel.TypeName(),
+			Constraints: &ast.List{},
+		}
+		stmt.TypeName.ArrayBounds = convertSlice(n.TypeName.ArrayBounds)
+		for _, c := range n.Constraints {
+			inner, ok := c.Node.(*nodes.Node_Constraint)
+			if !ok {
+				continue
+			}
+			if inner.Constraint.Contype == nodes.ConstrType_CONSTR_DEFAULT {
+				expr, err := deparseExpr(inner.Constraint.RawExpr)
+				if err != nil {
+					return nil, err
+				}
+				stmt.Default = expr
+			}
+			stmt.Constraints.Items = append(stmt.Constraints.Items, convertConstraint(inner.Constraint))
+		}
+		return stmt, nil
 
 	case *nodes.Node_CreateStmt:
 		n := inner.CreateStmt
 		rel := parseRelationFromRangeVar(n.Relation)
 		create := &ast.CreateTableStmt{
-			Name:        rel.TableName(),
-			IfNotExists: n.IfNotExists,
+			Name:           rel.TableName(),
+			IfNotExists:    n.IfNotExists,
+			PartitionBound: convertPartitionBoundSpec(n.Partbound),
+			PartitionSpec:  convertPartitionSpec(n.Partspec),
+		}
+		for _, item := range n.InhRelations {
+			rv, ok := item.Node.(*nodes.Node_RangeVar)
+			if !ok {
+				return nil, fmt.Errorf("nodes.CreateStmt: unknown type in inherits list: %T", item.Node)
+			}
+			create.Inherits = append(create.Inherits, parseRelationFromRangeVar(rv.RangeVar).TableName())
 		}
 		primaryKey := make(map[string]bool)
 		for _, elt := range n.TableElts {
 			switch item := elt.Node.(type) {
 			case *nodes.Node_Constraint:
+				create.Constraints = append(create.Constraints, convertConstraint(item.Constraint))
 				if item.Constraint.Contype == nodes.ConstrType_CONSTR_PRIMARY {
 					for _, key := range item.Constraint.Keys {
 						// FIXME: Possible nil pointer dereference
@@ -380,16 +605,25 @@ func translate(node *nodes.Node) (ast.Node, error) {
 		for _, elt := range n.TableElts {
 			switch item := elt.Node.(type) {
 			case *nodes.Node_ColumnDef:
-				rel, err := parseRelationFromNodes(item.ColumnDef.TypeName.Names)
-				if err != nil {
-					return nil, err
-				}
-				create.Cols = append(create.Cols, &ast.ColumnDef{
+				def := &ast.ColumnDef{
 					Colname:   item.ColumnDef.Colname,
-					TypeName:  rel.TypeName(),
 					IsNotNull: isNotNull(item.ColumnDef) || primaryKey[item.ColumnDef.Colname],
 					IsArray:   isArray(item.ColumnDef.TypeName),
-				})
+				}
+				// The columns of a partition only add options to the ones
+				// it inherits, so they don't have a type
+				if item.ColumnDef.TypeName != nil {
+					rel, err := parseRelationFromNodes(item.ColumnDef.TypeName.Names)
+					if err != nil {
+						return nil, err
+					}
+					def.TypeName = rel.TypeName()
+				}
+				if err := setColumnDefault(def, item.ColumnDef); err != nil {
+					return nil, err
+				}
+				create.Cols = append(create.Cols, def)
+				create.Constraints = append(create.Constraints, columnConstraints(item.ColumnDef)...)
 			}
 		}
 		return create, nil
@@ -427,12 +661,16 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				return nil, err
 			}
 			rt = rel.TypeName()
+			rt.Setof = n.ReturnType.Setof
+			rt.ArrayBounds = convertSlice(n.ReturnType.ArrayBounds)
 		}
 		stmt := &ast.CreateFunctionStmt{
-			Func:       fn.FuncName(),
-			ReturnType: rt,
-			Replace:    n.Replace,
-			Params:     &ast.List{},
+			Func:        fn.FuncName(),
+			ReturnType:  rt,
+			Replace:     n.Replace,
+			Params:      &ast.List{},
+			Options:     convertSlice(n.Options),
+			IsProcedure: n.IsProcedure,
 		}
 		for _, item := range n.Parameters {
 			arg := item.Node.(*nodes.Node_FunctionParameter).FunctionParameter
@@ -449,6 +687,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				Type: rel.TypeName(),
 				Mode: mode,
 			}
+			fp.Type.ArrayBounds = convertSlice(arg.ArgType.ArrayBounds)
 			if arg.Defexpr != nil {
 				fp.DefExpr = &ast.TODO{}
 			}
@@ -467,7 +706,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 		n := inner.DropStmt
 		switch n.RemoveType {
 
-		case nodes.ObjectType_OBJECT_FUNCTION:
+		case nodes.ObjectType_OBJECT_FUNCTION, nodes.ObjectType_OBJECT_PROCEDURE:
 			drop := &ast.DropFunctionStmt{
 				MissingOk: n.MissingOk,
 			}
@@ -514,7 +753,33 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
-		case nodes.ObjectType_OBJECT_TABLE:
+		case nodes.ObjectType_OBJECT_INDEX:
+			drop := &ast.DropIndexStmt{
+				IfExists: n.MissingOk,
+			}
+			for _, obj := range n.Objects {
+				name, err := parseRelation(obj)
+				if err != nil {
+					return nil, fmt.Errorf("nodes.DropStmt: INDEX: %w", err)
+				}
+				drop.Indexes = append(drop.Indexes, name.TableName())
+			}
+			return drop, nil
+
+		case nodes.ObjectType_OBJECT_SEQUENCE:
+			drop := &ast.DropSequenceStmt{
+				IfExists: n.MissingOk,
+			}
+			for _, obj := range n.Objects {
+				name, err := parseRelation(obj)
+				if err != nil {
+					return nil, fmt.Errorf("nodes.DropStmt: SEQUENCE: %w", err)
+				}
+				drop.Sequences = append(drop.Sequences, name.TableName())
+			}
+			return drop, nil
+
+		case nodes.ObjectType_OBJECT_TABLE, nodes.ObjectType_OBJECT_VIEW, nodes.ObjectType_OBJECT_MATVIEW:
 			drop := &ast.DropTableStmt{
 				IfExists: n.MissingOk,
 			}
@@ -527,7 +792,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			drop := &ast.DropTypeStmt{
 				IfExists: n.MissingOk,
 			}
@@ -555,6 +820,37 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				NewName: makeString(n.Newname),
 			}, nil
 
+		case nodes.ObjectType_OBJECT_INDEX:
+			rel := parseRelationFromRangeVar(n.Relation)
+			return &ast.RenameIndexStmt{
+				Index:   rel.TableName(),
+				NewName: makeString(n.Newname),
+			}, nil
+
+		case nodes.ObjectType_OBJECT_ATTRIBUTE:
+			rel := parseRelationFromRangeVar(n.Relation)
+			return &ast.AlterTypeRenameAttributeStmt{
+				Type:    rel.TypeName(),
+				OldName: makeString(n.Subname),
+				NewName: makeString(n.Newname),
+			}, nil
+
+		case nodes.ObjectType_OBJECT_TABCONSTRAINT:
+			rel := parseRelationFromRangeVar(n.Relation)
+			return &ast.RenameConstraintStmt{
+				Table:   rel.TableName(),
+				Name:    makeString(n.Subname),
+				NewName: makeString(n.Newname),
+			}, nil
+
+		case nodes.ObjectType_OBJECT_SEQUENCE:
+			rel := parseRelationFromRangeVar(n.Relation)
+			return &ast.RenameSequenceStmt{
+				Sequence:  rel.TableName(),
+				NewName:   makeString(n.Newname),
+				MissingOk: n.MissingOk,
+			}, nil
+
 		case nodes.ObjectType_OBJECT_TABLE:
 			rel := parseRelationFromRangeVar(n.Relation)
 			return &ast.RenameTableStmt{
@@ -562,7 +858,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				NewName: makeString(n.Newname),
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, fmt.Errorf("nodes.RenameStmt: TYPE: %w", err)
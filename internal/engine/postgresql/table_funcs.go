@@ -0,0 +1,47 @@
+package postgresql
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// The generated pg_catalog leaves out functions with OUT parameters. These
+// are the set-returning ones that are commonly used in a FROM clause.
+func tableFuncs() []*catalog.Function {
+	each := func(name, in, value string) *catalog.Function {
+		return &catalog.Function{
+			Name: name,
+			Args: []*catalog.Argument{
+				{Name: "from_json", Type: &ast.TypeName{Name: in}},
+				{Name: "key", Type: &ast.TypeName{Name: "text"}, Mode: ast.FuncParamOut},
+				{Name: "value", Type: &ast.TypeName{Name: value}, Mode: ast.FuncParamOut},
+			},
+			ReturnType: &ast.TypeName{Name: "record"},
+		}
+	}
+	elements := func(name, in, value string) *catalog.Function {
+		return &catalog.Function{
+			Name: name,
+			Args: []*catalog.Argument{
+				{Name: "from_json", Type: &ast.TypeName{Name: in}},
+				{Name: "value", Type: &ast.TypeName{Name: value}, Mode: ast.FuncParamOut},
+			},
+			ReturnType: &ast.TypeName{Name: value},
+		}
+	}
+	return []*catalog.Function{
+		each("json_each", "json", "json"),
+		each("json_each_text", "json", "text"),
+		each("jsonb_each", "jsonb", "jsonb"),
+		each("jsonb_each_text", "jsonb", "text"),
+		elements("json_array_elements", "json", "json"),
+		elements("json_array_elements_text", "json", "text"),
+		elements("jsonb_array_elements", "jsonb", "jsonb"),
+		elements("jsonb_array_elements_text", "jsonb", "text"),
+	}
+}
+
+func addTableFuncs(s *catalog.Schema) *catalog.Schema {
+	s.Funcs = append(s.Funcs, tableFuncs()...)
+	return s
+}
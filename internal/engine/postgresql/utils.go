@@ -33,7 +33,11 @@ func isNotNull(n *nodes.ColumnDef) bool {
 
 func IsNamedParamFunc(node *nodes.Node) bool {
 	fun, ok := node.Node.(*nodes.Node_FuncCall)
-	return ok && joinNodes(fun.FuncCall.Funcname, ".") == "sqlc.arg"
+	if !ok {
+		return false
+	}
+	name := joinNodes(fun.FuncCall.Funcname, ".")
+	return name == "sqlc.arg" || name == "sqlc.narg" || name == "sqlc.slice"
 }
 
 func IsNamedParamSign(node *nodes.Node) bool {
@@ -230,6 +230,105 @@ func TestUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			`
+			CREATE TABLE authors (id integer PRIMARY KEY, name text UNIQUE);
+			CREATE TABLE books (
+			  id integer,
+			  author_id integer REFERENCES authors ON DELETE CASCADE,
+			  PRIMARY KEY (id)
+			);
+			CREATE INDEX books_author ON books (author_id);
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "authors"},
+						Columns: []*catalog.Column{
+							{
+								Name:      "id",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name: "name",
+								Type: ast.TypeName{Name: "text"},
+							},
+						},
+						Constraints: []*catalog.Constraint{
+							{
+								Name:    "authors_pkey",
+								Type:    catalog.ConstraintPrimaryKey,
+								Columns: []string{"id"},
+							},
+							{
+								Name:    "authors_name_key",
+								Type:    catalog.ConstraintUnique,
+								Columns: []string{"name"},
+							},
+						},
+					},
+					{
+						Rel: &ast.TableName{Name: "books"},
+						Columns: []*catalog.Column{
+							{
+								Name:      "id",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name: "author_id",
+								Type: ast.TypeName{Name: "integer"},
+							},
+						},
+						Constraints: []*catalog.Constraint{
+							{
+								Name:       "books_author_id_fkey",
+								Type:       catalog.ConstraintForeignKey,
+								Columns:    []string{"author_id"},
+								RefTable:   &ast.TableName{Name: "authors"},
+								RefColumns: []string{"id"},
+								OnDelete:   catalog.ActionCascade,
+							},
+							{
+								Name:    "books_pkey",
+								Type:    catalog.ConstraintPrimaryKey,
+								Columns: []string{"id"},
+							},
+						},
+					},
+				},
+				Indexes: []*catalog.Index{
+					{
+						Name:    "books_author",
+						Table:   &ast.TableName{Name: "books"},
+						Columns: []string{"author_id"},
+					},
+				},
+			},
+		},
+		{
+			`
+			CREATE TABLE foo (bar text);
+			CREATE UNIQUE INDEX foo_bar ON foo (bar);
+			DROP INDEX foo_bar;
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "foo"},
+						Columns: []*catalog.Column{
+							{
+								Name: "bar",
+								Type: ast.TypeName{Name: "text"},
+							},
+						},
+					},
+				},
+			},
+		},
 	} {
 		test := tc
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
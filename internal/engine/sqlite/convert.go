@@ -11,6 +11,10 @@ type node interface {
 	GetParser() antlr.Parser
 }
 
+// convertAlter_table_stmtContext converts the forms of ALTER TABLE that the
+// grammar knows about: RENAME TO, RENAME COLUMN and ADD COLUMN. The grammar
+// predates DROP COLUMN, which SQLite added in 3.35.0, so schemas that drop
+// columns fail to parse until the parser is regenerated with support for it.
 func convertAlter_table_stmtContext(c *parser.Alter_table_stmtContext) ast.Node {
 	if newTable, ok := c.New_table_name().(*parser.New_table_nameContext); ok {
 		name := newTable.Any_name().GetText()
@@ -37,16 +41,25 @@ func convertAlter_table_stmtContext(c *parser.Alter_table_stmtContext) ast.Node
 			Cmds:  &ast.List{},
 		}
 		name := def.Column_name().GetText()
+		col := &ast.ColumnDef{
+			Colname:   name,
+			IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
+			TypeName: &ast.TypeName{
+				Name: def.Type_name().GetText(),
+			},
+		}
+		setColumnDefault(col, def)
 		stmt.Cmds.Items = append(stmt.Cmds.Items, &ast.AlterTableCmd{
 			Name:    &name,
 			Subtype: ast.AT_AddColumn,
-			Def: &ast.ColumnDef{
-				Colname: name,
-				TypeName: &ast.TypeName{
-					Name: def.Type_name().GetText(),
-				},
-			},
+			Def:     col,
 		})
+		for _, con := range columnConstraints(def) {
+			stmt.Cmds.Items = append(stmt.Cmds.Items, &ast.AlterTableCmd{
+				Subtype:    ast.AT_AddConstraint,
+				Constraint: con,
+			})
+		}
 		return stmt
 	}
 
@@ -67,16 +80,58 @@ func convertCreate_table_stmtContext(c *parser.Create_table_stmtContext) ast.Nod
 	}
 	for _, idef := range c.AllColumn_def() {
 		if def, ok := idef.(*parser.Column_defContext); ok {
-			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+			col := &ast.ColumnDef{
 				Colname:   def.Column_name().GetText(),
 				IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
 				TypeName:  &ast.TypeName{Name: def.Type_name().GetText()},
-			})
+			}
+			setColumnDefault(col, def)
+			stmt.Cols = append(stmt.Cols, col)
+			stmt.Constraints = append(stmt.Constraints, columnConstraints(def)...)
+		}
+	}
+	for _, icon := range c.AllTable_constraint() {
+		if con, ok := icon.(*parser.Table_constraintContext); ok {
+			if constraint := tableConstraint(con); constraint != nil {
+				stmt.Constraints = append(stmt.Constraints, constraint)
+			}
 		}
 	}
 	return stmt
 }
 
+func convertCreate_index_stmtContext(c *parser.Create_index_stmtContext) ast.Node {
+	name := c.Index_name().GetText()
+	table := c.Table_name().GetText()
+	stmt := &ast.IndexStmt{
+		Idxname:     &name,
+		Relation:    &ast.RangeVar{Relname: &table},
+		IndexParams: &ast.List{},
+		Unique:      c.K_UNIQUE() != nil,
+		IfNotExists: c.K_EXISTS() != nil,
+	}
+	if c.Database_name() != nil {
+		schema := c.Database_name().GetText()
+		stmt.Relation.Schemaname = &schema
+	}
+	for _, col := range indexedColumns(c.AllIndexed_column()) {
+		col := col
+		stmt.IndexParams.Items = append(stmt.IndexParams.Items, &ast.IndexElem{Name: &col})
+	}
+	return stmt
+}
+
+func convertDrop_index_stmtContext(c *parser.Drop_index_stmtContext) ast.Node {
+	name := &ast.TableName{Name: c.Index_name().GetText()}
+	if c.Database_name() != nil {
+		name.Schema = c.Database_name().GetText()
+	}
+	return &ast.DropIndexStmt{
+		IfExists: c.K_EXISTS() != nil,
+		Indexes:  []*ast.TableName{name},
+	}
+}
+
 func convertDrop_table_stmtContext(c *parser.Drop_table_stmtContext) ast.Node {
 	return &ast.DropTableStmt{
 		IfExists: c.K_EXISTS() != nil,
@@ -250,9 +305,15 @@ func convert(node node) ast.Node {
 	case *parser.Attach_stmtContext:
 		return convertAttach_stmtContext(n)
 
+	case *parser.Create_index_stmtContext:
+		return convertCreate_index_stmtContext(n)
+
 	case *parser.Create_table_stmtContext:
 		return convertCreate_table_stmtContext(n)
 
+	case *parser.Drop_index_stmtContext:
+		return convertDrop_index_stmtContext(n)
+
 	case *parser.Drop_table_stmtContext:
 		return convertDrop_table_stmtContext(n)
 
@@ -1,6 +1,10 @@
 package sqlite
 
 import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
 	"github.com/kyleconroy/sqlc/internal/engine/sqlite/parser"
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 )
@@ -35,3 +39,149 @@ func hasNotNullConstraint(checks []parser.IColumn_constraintContext) bool {
 	}
 	return false
 }
+
+func nameList(names []string) *ast.List {
+	list := &ast.List{}
+	for _, name := range names {
+		list.Items = append(list.Items, &ast.String{Str: name})
+	}
+	return list
+}
+
+func indexedColumns(cols []parser.IIndexed_columnContext) []string {
+	var names []string
+	for _, icol := range cols {
+		if col, ok := icol.(*parser.Indexed_columnContext); ok {
+			names = append(names, col.Column_name().GetText())
+		}
+	}
+	return names
+}
+
+func constraintName(name parser.INameContext) *string {
+	if name == nil {
+		return nil
+	}
+	text := name.GetText()
+	return &text
+}
+
+// foreignKey converts a REFERENCES clause. The ON DELETE and ON UPDATE actions
+// are stored using the same letters as ast.Constraint.
+func foreignKey(fk *parser.Foreign_key_clauseContext, cols []string) *ast.Constraint {
+	table := fk.Foreign_table().GetText()
+	con := &ast.Constraint{
+		Contype: ast.ConstrTypeForeign,
+		FkAttrs: nameList(cols),
+		Pktable: &ast.RangeVar{Relname: &table},
+	}
+	var refs []string
+	for _, icol := range fk.AllColumn_name() {
+		refs = append(refs, icol.GetText())
+	}
+	con.PkAttrs = nameList(refs)
+
+	var tokens []string
+	for _, child := range fk.GetChildren() {
+		if t, ok := child.(antlr.TerminalNode); ok {
+			tokens = append(tokens, strings.ToUpper(t.GetText()))
+		}
+	}
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i] != "ON" {
+			continue
+		}
+		var action byte
+		switch tokens[i+2] {
+		case "SET":
+			if i+3 < len(tokens) && tokens[i+3] == "NULL" {
+				action = 'n'
+			} else {
+				action = 'd'
+			}
+		case "CASCADE":
+			action = 'c'
+		case "RESTRICT":
+			action = 'r'
+		case "NO":
+			action = 'a'
+		}
+		switch tokens[i+1] {
+		case "DELETE":
+			con.FkDelAction = action
+		case "UPDATE":
+			con.FkUpdAction = action
+		}
+	}
+	return con
+}
+
+// columnConstraints returns the keys and checks declared on a column
+func columnConstraints(def *parser.Column_defContext) []*ast.Constraint {
+	var constraints []*ast.Constraint
+	col := def.Column_name().GetText()
+	for _, icon := range def.AllColumn_constraint() {
+		c, ok := icon.(*parser.Column_constraintContext)
+		if !ok {
+			continue
+		}
+		var con *ast.Constraint
+		switch {
+		case c.K_PRIMARY() != nil:
+			con = &ast.Constraint{Contype: ast.ConstrTypePrimary, Keys: nameList([]string{col})}
+		case c.K_UNIQUE() != nil:
+			con = &ast.Constraint{Contype: ast.ConstrTypeUnique, Keys: nameList([]string{col})}
+		case c.K_CHECK() != nil:
+			con = &ast.Constraint{Contype: ast.ConstrTypeCheck, Keys: nameList([]string{col})}
+		case c.Foreign_key_clause() != nil:
+			con = foreignKey(c.Foreign_key_clause().(*parser.Foreign_key_clauseContext), []string{col})
+		default:
+			continue
+		}
+		con.Conname = constraintName(c.Name())
+		constraints = append(constraints, con)
+	}
+	return constraints
+}
+
+func tableConstraint(c *parser.Table_constraintContext) *ast.Constraint {
+	var con *ast.Constraint
+	switch {
+	case c.K_PRIMARY() != nil:
+		con = &ast.Constraint{Contype: ast.ConstrTypePrimary, Keys: nameList(indexedColumns(c.AllIndexed_column()))}
+	case c.K_UNIQUE() != nil:
+		con = &ast.Constraint{Contype: ast.ConstrTypeUnique, Keys: nameList(indexedColumns(c.AllIndexed_column()))}
+	case c.K_CHECK() != nil:
+		// Expressions aren't converted yet, so the checked columns are unknown
+		con = &ast.Constraint{Contype: ast.ConstrTypeCheck}
+	case c.Foreign_key_clause() != nil:
+		var cols []string
+		for _, icol := range c.AllColumn_name() {
+			cols = append(cols, icol.GetText())
+		}
+		con = foreignKey(c.Foreign_key_clause().(*parser.Foreign_key_clauseContext), cols)
+	default:
+		return nil
+	}
+	con.Conname = constraintName(c.Name())
+	return con
+}
+
+// setColumnDefault records the default and AUTOINCREMENT setting of a column
+func setColumnDefault(col *ast.ColumnDef, def *parser.Column_defContext) {
+	for _, icon := range def.AllColumn_constraint() {
+		c, ok := icon.(*parser.Column_constraintContext)
+		if !ok {
+			continue
+		}
+		if c.K_AUTOINCREMENT() != nil {
+			col.Identity = 'd'
+		}
+		if c.K_DEFAULT() != nil {
+			start := c.K_DEFAULT().GetSymbol().GetStop() + 1
+			stop := c.GetStop().GetStop()
+			text := c.GetStart().GetInputStream().GetText(start, stop)
+			col.Default = strings.TrimSpace(text)
+		}
+	}
+}
@@ -18,25 +18,66 @@ const (
 	CmdExecRows   = ":execrows"
 	CmdMany       = ":many"
 	CmdOne        = ":one"
+	CmdBatchExec  = ":batchexec"
+	CmdBatchMany  = ":batchmany"
+	CmdBatchOne   = ":batchone"
+	CmdCopyFrom   = ":copyfrom"
 )
 
+// IsBatch reports whether the command sends its queries using a pgx batch
+func IsBatch(cmd string) bool {
+	switch cmd {
+	case CmdBatchExec, CmdBatchMany, CmdBatchOne:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	AnnotationParam  = "@param"
+	AnnotationColumn = "@column"
+)
+
+// Annotation is a per-query directive, given as a comment of the query:
+//
+//	-- @param cutoff time.Time
+//	-- @column metadata github.com/acme/types.OrderMetadata
+//
+// The @param annotations name the parameters of the query in order, and can
+// give them a type. A @column annotation gives a type to the result column
+// with the given name. Types are written in the language of the generated
+// code.
+type Annotation struct {
+	Kind string
+	Name string
+	Type string
+}
+
 // A query name must be a valid Go identifier
 //
 // https://golang.org/ref/spec#Identifiers
 func validateQueryName(name string) error {
+	if !isIdentifier(name) {
+		return fmt.Errorf("invalid query name %q", name)
+	}
+	return nil
+}
+
+func isIdentifier(name string) bool {
 	if len(name) == 0 {
-		return fmt.Errorf("invalid query name: %q", name)
+		return false
 	}
 	for i, c := range name {
 		isLetter := unicode.IsLetter(c) || c == '_'
 		isDigit := unicode.IsDigit(c)
 		if i == 0 && !isLetter {
-			return fmt.Errorf("invalid query name %q", name)
+			return false
 		} else if !(isLetter || isDigit) {
-			return fmt.Errorf("invalid query name %q", name)
+			return false
 		}
 	}
-	return nil
+	return true
 }
 
 func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
@@ -72,7 +113,7 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 			part = part[:len(part)-1] // removes the trailing "*/" element
 		}
 		if len(part) == 2 {
-			return "", "", fmt.Errorf("missing query type [':one', ':many', ':exec', ':execrows', ':execresult']: %s", line)
+			return "", "", fmt.Errorf("missing query type [':one', ':many', ':exec', ':execrows', ':execresult', ':batchexec', ':batchmany', ':batchone', ':copyfrom']: %s", line)
 		}
 		if len(part) != 4 {
 			return "", "", fmt.Errorf("invalid query comment: %s", line)
@@ -81,6 +122,8 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 		queryType := strings.TrimSpace(part[3])
 		switch queryType {
 		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows:
+		case CmdBatchExec, CmdBatchMany, CmdBatchOne:
+		case CmdCopyFrom:
 		default:
 			return "", "", fmt.Errorf("invalid query type: %s", queryType)
 		}
@@ -91,3 +134,60 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 	}
 	return "", "", nil
 }
+
+// ParseAnnotations returns the @param and @column annotations in the comments
+// of a query. Other comments, including ones that start with a different @
+// word or that are longer than an annotation, are left alone.
+func ParseAnnotations(t string, commentStyle CommentSyntax) ([]Annotation, error) {
+	var annotations []Annotation
+	for _, line := range strings.Split(t, "\n") {
+		body, ok := commentBody(line, commentStyle)
+		if !ok || !IsAnnotation(body) {
+			continue
+		}
+		part := strings.Fields(body)
+		a := Annotation{Kind: part[0]}
+		switch a.Kind {
+		case AnnotationParam:
+			if len(part) != 2 && len(part) != 3 {
+				return nil, fmt.Errorf("invalid annotation, expected '%s name [type]': %s", a.Kind, line)
+			}
+			if !isIdentifier(part[1]) {
+				return nil, fmt.Errorf("invalid parameter name %q: %s", part[1], line)
+			}
+		case AnnotationColumn:
+			if len(part) != 3 {
+				return nil, fmt.Errorf("invalid annotation, expected '%s name type': %s", a.Kind, line)
+			}
+		}
+		a.Name = part[1]
+		if len(part) == 3 {
+			a.Type = part[2]
+		}
+		annotations = append(annotations, a)
+	}
+	return annotations, nil
+}
+
+// IsAnnotation reports whether the text of a comment, without the comment
+// markers, is an annotation. Comments with more words than an annotation has,
+// such as "@param id the ID of the user", are documentation instead.
+func IsAnnotation(comment string) bool {
+	part := strings.Fields(comment)
+	if len(part) == 0 || len(part) > 3 {
+		return false
+	}
+	return part[0] == AnnotationParam || part[0] == AnnotationColumn
+}
+
+func commentBody(line string, commentStyle CommentSyntax) (string, bool) {
+	switch {
+	case commentStyle.Dash && strings.HasPrefix(line, "--"):
+		return strings.TrimPrefix(line, "--"), true
+	case commentStyle.Hash && strings.HasPrefix(line, "#"):
+		return strings.TrimPrefix(line, "#"), true
+	case commentStyle.SlashStar && strings.HasPrefix(line, "/*") && strings.HasSuffix(line, "*/"):
+		return strings.TrimSuffix(strings.TrimPrefix(line, "/*"), "*/"), true
+	}
+	return "", false
+}
@@ -1,6 +1,10 @@
 package metadata
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
 
 func TestParseMetadata(t *testing.T) {
 	for _, query := range []string{
@@ -10,9 +14,72 @@ func TestParseMetadata(t *testing.T) {
 		`-- name: CreateFoo`,
 		`-- name: CreateFoo :one something`,
 		`-- name: `,
+		`-- name: CreateFoo :batch`,
+		`-- name: CreateFoo :copy`,
 	} {
 		if _, _, err := Parse(query, CommentSyntax{Dash: true}); err == nil {
 			t.Errorf("expected invalid metadata: %q", query)
 		}
 	}
 }
+
+func TestParseBatchMetadata(t *testing.T) {
+	for _, cmd := range []string{CmdBatchExec, CmdBatchMany, CmdBatchOne} {
+		query := "-- name: CreateFoo " + cmd
+		name, parsed, err := Parse(query, CommentSyntax{Dash: true})
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", query, err)
+		}
+		if name != "CreateFoo" || parsed != cmd {
+			t.Errorf("parsed %q as (%q, %q)", query, name, parsed)
+		}
+		if !IsBatch(parsed) {
+			t.Errorf("expected %s to be a batch command", parsed)
+		}
+	}
+}
+
+func TestParseCopyFromMetadata(t *testing.T) {
+	name, cmd, err := Parse("-- name: CopyFoo :copyfrom", CommentSyntax{Dash: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "CopyFoo" || cmd != CmdCopyFrom {
+		t.Errorf("parsed as (%q, %q)", name, cmd)
+	}
+}
+
+func TestParseAnnotations(t *testing.T) {
+	query := `-- name: ListOrders :many
+-- Orders created before the cutoff
+-- @param cutoff time.Time
+-- @param status
+/* @column metadata github.com/acme/types.OrderMetadata */
+-- @deprecated
+-- @param id the ID of the order
+SELECT * FROM orders WHERE created_at < $1 AND status = $2;`
+	annotations, err := ParseAnnotations(query, CommentSyntax{Dash: true, SlashStar: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Annotation{
+		{Kind: AnnotationParam, Name: "cutoff", Type: "time.Time"},
+		{Kind: AnnotationParam, Name: "status"},
+		{Kind: AnnotationColumn, Name: "metadata", Type: "github.com/acme/types.OrderMetadata"},
+	}
+	if diff := cmp.Diff(expected, annotations); diff != "" {
+		t.Errorf("annotations differ (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseInvalidAnnotations(t *testing.T) {
+	for _, query := range []string{
+		`-- @param`,
+		`-- @param 1cutoff`,
+		`-- @column metadata`,
+	} {
+		if _, err := ParseAnnotations(query, CommentSyntax{Dash: true}); err == nil {
+			t.Errorf("expected invalid annotation: %q", query)
+		}
+	}
+}
@@ -7,6 +7,9 @@ type AlterDomainStmt struct {
 	Def       Node
 	Behavior  DropBehavior
 	MissingOk bool
+
+	// Default holds the SQL text of the DEFAULT expression
+	Default string
 }
 
 func (n *AlterDomainStmt) Pos() int {
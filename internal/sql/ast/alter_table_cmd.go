@@ -6,6 +6,19 @@ const (
 	AT_DropColumn
 	AT_DropNotNull
 	AT_SetNotNull
+	AT_AddConstraint
+	AT_DropConstraint
+	AT_AddIndex
+	AT_DropIndex
+	AT_AttachPartition
+	AT_DetachPartition
+	AT_AddInherit
+	AT_DropInherit
+	AT_ColumnDefault
+	AT_AddIdentity
+	AT_DropIdentity
+	AT_DropExpression
+	AT_RenameColumn
 )
 
 type AlterTableType int
@@ -22,18 +35,53 @@ func (t AlterTableType) String() string {
 		return "DropNotNull"
 	case AT_SetNotNull:
 		return "SetNotNull"
+	case AT_AddConstraint:
+		return "AddConstraint"
+	case AT_DropConstraint:
+		return "DropConstraint"
+	case AT_AddIndex:
+		return "AddIndex"
+	case AT_DropIndex:
+		return "DropIndex"
+	case AT_AttachPartition:
+		return "AttachPartition"
+	case AT_DetachPartition:
+		return "DetachPartition"
+	case AT_AddInherit:
+		return "AddInherit"
+	case AT_DropInherit:
+		return "DropInherit"
+	case AT_ColumnDefault:
+		return "ColumnDefault"
+	case AT_AddIdentity:
+		return "AddIdentity"
+	case AT_DropIdentity:
+		return "DropIdentity"
+	case AT_DropExpression:
+		return "DropExpression"
+	case AT_RenameColumn:
+		return "RenameColumn"
 	default:
 		return "Unknown"
 	}
 }
 
 type AlterTableCmd struct {
-	Subtype   AlterTableType
-	Name      *string
-	Def       *ColumnDef
-	Newowner  *RoleSpec
-	Behavior  DropBehavior
-	MissingOk bool
+	Subtype AlterTableType
+	Name    *string
+	// Def holds the new definition of the column. For AT_ColumnDefault only
+	// its Default is set, which is empty for DROP DEFAULT, and AT_RenameColumn
+	// only sets its Colname.
+	Def        *ColumnDef
+	Constraint *Constraint
+	Index      *IndexStmt
+	// Relation is the partition for ATTACH and DETACH PARTITION, and the
+	// parent table for INHERIT and NO INHERIT
+	Relation       *TableName
+	PartitionBound *PartitionBoundSpec
+	Newowner       *RoleSpec
+	Behavior       DropBehavior
+	MissingOk      bool
 }
 
 func (n *AlterTableCmd) Pos() int {
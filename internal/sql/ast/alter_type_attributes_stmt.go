@@ -0,0 +1,13 @@
+package ast
+
+// AlterTypeAttributesStmt adds, drops or alters the attributes of a composite
+// type. Each command is an AlterTableCmd, as ALTER TYPE shares its syntax with
+// ALTER TABLE.
+type AlterTypeAttributesStmt struct {
+	Type *TypeName
+	Cmds *List
+}
+
+func (n *AlterTypeAttributesStmt) Pos() int {
+	return 0
+}
@@ -0,0 +1,11 @@
+package ast
+
+type AlterTypeRenameAttributeStmt struct {
+	Type    *TypeName
+	OldName *string
+	NewName *string
+}
+
+func (n *AlterTypeRenameAttributeStmt) Pos() int {
+	return 0
+}
@@ -1,5 +1,14 @@
 package ast
 
+// BoolExprType is the reported type of the boolean expression
+// Enum copies https://github.com/pganalyze/libpg_query/blob/13-latest/protobuf/pg_query.proto
+const (
+	_ BoolExprType = iota
+	BoolExprTypeAnd
+	BoolExprTypeOr
+	BoolExprTypeNot
+)
+
 type BoolExprType uint
 
 func (n *BoolExprType) Pos() int {
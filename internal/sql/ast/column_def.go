@@ -7,6 +7,8 @@ type ColumnDef struct {
 	IsArray   bool
 	Vals      *List
 	Length    *int
+	// Default holds the SQL text of the column's DEFAULT expression
+	Default string
 
 	// From pg.ColumnDef
 	Inhcount      int
@@ -17,6 +19,7 @@ type ColumnDef struct {
 	RawDefault    Node
 	CookedDefault Node
 	Identity      byte
+	Generated     byte
 	CollClause    *CollateClause
 	CollOid       Oid
 	Constraints   *List
@@ -1,5 +1,25 @@
 package ast
 
+// ConstrType is the type of a table or column constraint
+// Enum copies https://github.com/pganalyze/libpg_query/blob/13-latest/protobuf/pg_query.proto
+const (
+	_ ConstrType = iota
+	ConstrTypeNull
+	ConstrTypeNotNull
+	ConstrTypeDefault
+	ConstrTypeIdentity
+	ConstrTypeGenerated
+	ConstrTypeCheck
+	ConstrTypePrimary
+	ConstrTypeUnique
+	ConstrTypeExclusion
+	ConstrTypeForeign
+	ConstrTypeAttrDeferrable
+	ConstrTypeAttrNotDeferrable
+	ConstrTypeAttrDeferred
+	ConstrTypeAttrImmediate
+)
+
 type ConstrType uint
 
 func (n *ConstrType) Pos() int {
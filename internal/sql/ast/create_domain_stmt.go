@@ -5,6 +5,9 @@ type CreateDomainStmt struct {
 	TypeName    *TypeName
 	CollClause  *CollateClause
 	Constraints *List
+
+	// Default holds the SQL text of the DEFAULT expression
+	Default string
 }
 
 func (n *CreateDomainStmt) Pos() int {
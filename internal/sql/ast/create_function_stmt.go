@@ -1,10 +1,11 @@
 package ast
 
 type CreateFunctionStmt struct {
-	Replace    bool
-	Params     *List
-	ReturnType *TypeName
-	Func       *FuncName
+	Replace     bool
+	Params      *List
+	ReturnType  *TypeName
+	Func        *FuncName
+	IsProcedure bool
 	// TODO: Undertand these two fields
 	Options    *List
 	WithClause *List
@@ -6,6 +6,17 @@ type CreateTableStmt struct {
 	Cols        []*ColumnDef
 	ReferTable  *TableName
 	Comment     string
+	// Constraints holds both table constraints and the constraints declared
+	// on individual columns
+	Constraints []*Constraint
+	// Indexes declared inside the table definition, as MySQL allows
+	Indexes []*IndexStmt
+	// Inherits lists the parent tables, from INHERITS or PARTITION OF
+	Inherits []*TableName
+	// PartitionBound is set for PARTITION OF, and PartitionSpec for a
+	// partitioned table's PARTITION BY clause
+	PartitionBound *PartitionBoundSpec
+	PartitionSpec  *PartitionSpec
 }
 
 func (n *CreateTableStmt) Pos() int {
@@ -0,0 +1,13 @@
+package ast
+
+type DropIndexStmt struct {
+	IfExists bool
+	// Indexes share a namespace with tables, so their names are table names
+	Indexes []*TableName
+	// Table is set by engines, such as MySQL, that scope indexes to a table
+	Table *TableName
+}
+
+func (n *DropIndexStmt) Pos() int {
+	return 0
+}
@@ -0,0 +1,10 @@
+package ast
+
+type DropSequenceStmt struct {
+	IfExists  bool
+	Sequences []*TableName
+}
+
+func (n *DropSequenceStmt) Pos() int {
+	return 0
+}
@@ -1,5 +1,13 @@
 package ast
 
+// NullTestType is the reported type of the null test
+// Enum copies https://github.com/pganalyze/libpg_query/blob/13-latest/protobuf/pg_query.proto
+const (
+	_ NullTestType = iota
+	NullTestTypeIsNull
+	NullTestTypeIsNotNull
+)
+
 type NullTestType uint
 
 func (n *NullTestType) Pos() int {
@@ -2,6 +2,14 @@ package ast
 
 type OnConflictAction uint
 
+// The values match the PostgreSQL OnConflictAction enum as exposed by pg_query
+const (
+	OnConflictAction_UNDEFINED OnConflictAction = iota
+	ONCONFLICT_NONE
+	ONCONFLICT_NOTHING
+	ONCONFLICT_UPDATE
+)
+
 func (n *OnConflictAction) Pos() int {
 	return 0
 }
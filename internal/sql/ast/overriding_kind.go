@@ -2,6 +2,13 @@ package ast
 
 type OverridingKind uint
 
+const (
+	_ OverridingKind = iota
+	OverridingNotSet
+	OverridingUserValue
+	OverridingSystemValue
+)
+
 func (n *OverridingKind) Pos() int {
 	return 0
 }
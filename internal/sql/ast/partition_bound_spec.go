@@ -2,6 +2,9 @@ package ast
 
 type PartitionBoundSpec struct {
 	Strategy    byte
+	IsDefault   bool
+	Modulus     int
+	Remainder   int
 	Listdatums  *List
 	Lowerdatums *List
 	Upperdatums *List
@@ -0,0 +1,11 @@
+package ast
+
+type RenameConstraintStmt struct {
+	Table   *TableName
+	Name    *string
+	NewName *string
+}
+
+func (n *RenameConstraintStmt) Pos() int {
+	return 0
+}
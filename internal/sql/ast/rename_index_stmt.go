@@ -0,0 +1,12 @@
+package ast
+
+type RenameIndexStmt struct {
+	Index   *TableName
+	NewName *string
+	// Table is set by engines, such as MySQL, that scope indexes to a table
+	Table *TableName
+}
+
+func (n *RenameIndexStmt) Pos() int {
+	return 0
+}
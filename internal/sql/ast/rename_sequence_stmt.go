@@ -0,0 +1,11 @@
+package ast
+
+type RenameSequenceStmt struct {
+	Sequence  *TableName
+	NewName   *string
+	MissingOk bool
+}
+
+func (n *RenameSequenceStmt) Pos() int {
+	return 0
+}
@@ -2,6 +2,17 @@ package ast
 
 type VariableSetKind uint
 
+// The values match the PostgreSQL VariableSetKind enum as exposed by pg_query
+const (
+	VariableSetKind_UNDEFINED VariableSetKind = iota
+	VAR_SET_VALUE
+	VAR_SET_DEFAULT
+	VAR_SET_CURRENT
+	VAR_SET_MULTI
+	VAR_RESET
+	VAR_RESET_ALL
+)
+
 func (n *VariableSetKind) Pos() int {
 	return 0
 }
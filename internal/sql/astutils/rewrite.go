@@ -149,6 +149,13 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.AlterTypeAddValueStmt:
 		a.apply(n, "Type", nil, n.Type)
 
+	case *ast.AlterTypeAttributesStmt:
+		a.apply(n, "Type", nil, n.Type)
+		a.apply(n, "Cmds", nil, n.Cmds)
+
+	case *ast.AlterTypeRenameAttributeStmt:
+		a.apply(n, "Type", nil, n.Type)
+
 	case *ast.AlterTypeRenameValueStmt:
 		a.apply(n, "Type", nil, n.Type)
 
@@ -171,9 +178,15 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
+	case *ast.DropSequenceStmt:
+		// pass
+
 	case *ast.DropTableStmt:
 		// pass
 
@@ -201,6 +214,16 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "Table", nil, n.Table)
 		a.apply(n, "Col", nil, n.Col)
 
+	case *ast.RenameConstraintStmt:
+		a.apply(n, "Table", nil, n.Table)
+
+	case *ast.RenameIndexStmt:
+		a.apply(n, "Index", nil, n.Index)
+		a.apply(n, "Table", nil, n.Table)
+
+	case *ast.RenameSequenceStmt:
+		a.apply(n, "Sequence", nil, n.Sequence)
+
 	case *ast.RenameTableStmt:
 		a.apply(n, "Table", nil, n.Table)
 
@@ -359,6 +382,10 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.AlterTableCmd:
 		a.apply(n, "Newowner", nil, n.Newowner)
 		a.apply(n, "Def", nil, n.Def)
+		a.apply(n, "Constraint", nil, n.Constraint)
+		a.apply(n, "Index", nil, n.Index)
+		a.apply(n, "Relation", nil, n.Relation)
+		a.apply(n, "PartitionBound", nil, n.PartitionBound)
 
 	case *ast.AlterTableMoveAllStmt:
 		a.apply(n, "Roles", nil, n.Roles)
@@ -408,6 +435,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "Xpr", nil, n.Xpr)
 		a.apply(n, "Arg", nil, n.Arg)
 
+	case *ast.CallStmt:
+		a.apply(n, "FuncCall", nil, n.FuncCall)
+
 	case *ast.CaseExpr:
 		a.apply(n, "Xpr", nil, n.Xpr)
 		a.apply(n, "Arg", nil, n.Arg)
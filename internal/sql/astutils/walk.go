@@ -44,6 +44,19 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Type)
 		}
 
+	case *ast.AlterTypeAttributesStmt:
+		if n.Type != nil {
+			Walk(f, n.Type)
+		}
+		if n.Cmds != nil {
+			Walk(f, n.Cmds)
+		}
+
+	case *ast.AlterTypeRenameAttributeStmt:
+		if n.Type != nil {
+			Walk(f, n.Type)
+		}
+
 	case *ast.AlterTypeRenameValueStmt:
 		if n.Type != nil {
 			Walk(f, n.Type)
@@ -85,9 +98,15 @@ func Walk(f Visitor, node ast.Node) {
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
+	case *ast.DropSequenceStmt:
+		// pass
+
 	case *ast.DropTableStmt:
 		// pass
 
@@ -123,6 +142,24 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Col)
 		}
 
+	case *ast.RenameConstraintStmt:
+		if n.Table != nil {
+			Walk(f, n.Table)
+		}
+
+	case *ast.RenameIndexStmt:
+		if n.Index != nil {
+			Walk(f, n.Index)
+		}
+		if n.Table != nil {
+			Walk(f, n.Table)
+		}
+
+	case *ast.RenameSequenceStmt:
+		if n.Sequence != nil {
+			Walk(f, n.Sequence)
+		}
+
 	case *ast.RenameTableStmt:
 		if n.Table != nil {
 			Walk(f, n.Table)
@@ -418,6 +455,18 @@ func Walk(f Visitor, node ast.Node) {
 		if n.Def != nil {
 			Walk(f, n.Def)
 		}
+		if n.Constraint != nil {
+			Walk(f, n.Constraint)
+		}
+		if n.Index != nil {
+			Walk(f, n.Index)
+		}
+		if n.Relation != nil {
+			Walk(f, n.Relation)
+		}
+		if n.PartitionBound != nil {
+			Walk(f, n.PartitionBound)
+		}
 
 	case *ast.AlterTableMoveAllStmt:
 		if n.Roles != nil {
@@ -500,6 +549,11 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Arg)
 		}
 
+	case *ast.CallStmt:
+		if n.FuncCall != nil {
+			Walk(f, n.FuncCall)
+		}
+
 	case *ast.CaseExpr:
 		if n.Xpr != nil {
 			Walk(f, n.Xpr)
@@ -22,9 +22,19 @@ type Catalog struct {
 	DefaultSchema string
 	Name          string
 	Schemas       []*Schema
-	SearchPath    []string
 	LoadExtension func(string) *Schema
 
+	// SearchPath lists the schemas that unqualified names are resolved
+	// against, in order. When it's nil, only the default schema is used.
+	SearchPath []string
+	// SystemSchema is searched before the search path, unless the search path
+	// includes it
+	SystemSchema string
+	// NameConstraint names a constraint that was declared without a name.
+	// When it's nil or returns an empty string, the constraint is named the
+	// way PostgreSQL names it.
+	NameConstraint func(*Table, *Constraint) string
+
 	// TODO: un-export
 	Extensions map[string]struct{}
 }
@@ -39,56 +49,52 @@ func (c *Catalog) getSchema(name string) (*Schema, error) {
 }
 
 func (c *Catalog) getFunc(rel *ast.FuncName, tns []*ast.TypeName) (*Function, int, error) {
-	ns := rel.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	s, err := c.getSchema(ns)
+	schemas, err := c.schemasFor(rel.Schema)
 	if err != nil {
 		return nil, -1, err
 	}
-	return s.getFunc(rel, tns)
+	for _, s := range schemas {
+		if fn, idx, err := s.getFunc(rel, tns); err == nil {
+			return fn, idx, nil
+		}
+	}
+	return nil, -1, sqlerr.RelationNotFound(rel.Name)
 }
 
 func (c *Catalog) getTable(name *ast.TableName) (*Schema, *Table, error) {
-	ns := name.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	var s *Schema
-	for i := range c.Schemas {
-		if c.Schemas[i].Name == ns {
-			s = c.Schemas[i]
-			break
-		}
-	}
-	if s == nil {
-		return nil, nil, sqlerr.SchemaNotFound(ns)
-	}
-	t, _, err := s.getTable(name)
+	schemas, err := c.schemasFor(name.Schema)
 	if err != nil {
 		return nil, nil, err
 	}
-	return s, t, nil
+	for _, s := range schemas {
+		if t, _, err := s.getTable(name); err == nil {
+			return s, t, nil
+		}
+	}
+	return nil, nil, sqlerr.RelationNotFound(name.Name)
 }
 
-func (c *Catalog) getType(rel *ast.TypeName) (Type, int, error) {
-	ns := rel.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	s, err := c.getSchema(ns)
+func (c *Catalog) getType(rel *ast.TypeName) (*Schema, Type, int, error) {
+	schemas, err := c.schemasFor(rel.Schema)
 	if err != nil {
-		return nil, -1, err
+		return nil, nil, -1, err
 	}
-	return s.getType(rel)
+	for _, s := range schemas {
+		if typ, idx, err := s.getType(rel); err == nil {
+			return s, typ, idx, nil
+		}
+	}
+	return nil, nil, -1, sqlerr.TypeNotFound(rel.Name)
 }
 
 type Schema struct {
-	Name   string
-	Tables []*Table
-	Types  []Type
-	Funcs  []*Function
+	Name    string
+	Tables  []*Table
+	Types   []Type
+	Funcs   []*Function
+	Indexes []*Index
+	// Sequences share a namespace with tables and indexes
+	Sequences []*Sequence
 
 	Comment string
 }
@@ -175,15 +181,31 @@ func (s *Schema) getType(rel *ast.TypeName) (Type, int, error) {
 			if typ.Name == rel.Name {
 				return s.Types[i], i, nil
 			}
+		case *Domain:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
+		case *CompositeType:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
 		}
 	}
 	return nil, -1, sqlerr.TypeNotFound(rel.Name)
 }
 
 type Table struct {
-	Rel     *ast.TableName
-	Columns []*Column
-	Comment string
+	Rel         *ast.TableName
+	Columns     []*Column
+	Comment     string
+	Constraints []*Constraint
+	// Inherits holds the parent tables, in the order they were listed. A
+	// partition has a single parent, its partitioned table.
+	Inherits []*ast.TableName
+	// PartitionBound is the FOR VALUES clause of a partition
+	PartitionBound *ast.PartitionBoundSpec
+	// PartitionKey is the PARTITION BY clause of a partitioned table
+	PartitionKey *ast.PartitionSpec
 }
 
 // TODO: Should this just be ast Nodes?
@@ -194,6 +216,68 @@ type Column struct {
 	IsArray   bool
 	Comment   string
 	Length    *int
+
+	// Default holds the SQL text of the column's DEFAULT expression
+	Default string
+	// Identity is 'a' for GENERATED ALWAYS and 'd' for GENERATED BY DEFAULT
+	// identity columns, as in pg_attribute.attidentity
+	Identity byte
+	// Generated is 's' for stored and 'v' for virtual generated columns, as in
+	// pg_attribute.attgenerated
+	Generated byte
+	// Inherited is set for columns that a table only has because of its
+	// parent tables, rather than declaring them itself
+	Inherited bool
+}
+
+type ConstraintType int
+
+const (
+	ConstraintPrimaryKey ConstraintType = iota
+	ConstraintUnique
+	ConstraintForeignKey
+	ConstraintCheck
+	ConstraintExclusion
+)
+
+type ForeignKeyAction int
+
+const (
+	ActionNoAction ForeignKeyAction = iota
+	ActionRestrict
+	ActionCascade
+	ActionSetNull
+	ActionSetDefault
+)
+
+type Constraint struct {
+	Name    string
+	Type    ConstraintType
+	Columns []string
+
+	// Foreign keys reference columns in another table
+	RefTable   *ast.TableName
+	RefColumns []string
+	OnDelete   ForeignKeyAction
+	OnUpdate   ForeignKeyAction
+}
+
+type Index struct {
+	Name  string
+	Table *ast.TableName
+	// Expressions in the index are recorded as empty column names
+	Columns []string
+	Unique  bool
+}
+
+type Sequence struct {
+	Name string
+	// Type is the data type given with AS, bigint by default
+	Type ast.TypeName
+	// Serial and identity columns own their sequence, which is dropped along
+	// with the column
+	OwnedBy       *ast.TableName
+	OwnedByColumn string
 }
 
 type Type interface {
@@ -217,12 +301,31 @@ func (e *Enum) isType() {
 
 type CompositeType struct {
 	Name    string
+	Columns []*Column
 	Comment string
 }
 
 func (ct *CompositeType) isType() {
 }
 
+// A Domain is a type based on another type, with optional constraints
+type Domain struct {
+	Name      string
+	BaseType  ast.TypeName
+	IsArray   bool
+	IsNotNull bool
+	// Default holds the SQL text of the domain's DEFAULT expression
+	Default string
+	Comment string
+}
+
+func (d *Domain) isType() {
+}
+
+func (d *Domain) SetComment(c string) {
+	d.Comment = c
+}
+
 func (ct *CompositeType) SetComment(c string) {
 	ct.Comment = c
 }
@@ -231,8 +334,17 @@ type Function struct {
 	Name       string
 	Args       []*Argument
 	ReturnType *ast.TypeName
-	Comment    string
-	Desc       string
+	// ReturnTypeNullable is set if the function can return NULL even when
+	// none of its arguments are NULL, e.g. an aggregate over zero rows
+	ReturnTypeNullable bool
+	// Strict functions always return NULL if any of their arguments is NULL
+	Strict bool
+	// Procedures are invoked with CALL and don't have a return type
+	IsProcedure bool
+	// Extension is set for functions created by CREATE EXTENSION
+	Extension string
+	Comment   string
+	Desc      string
 }
 
 func (f *Function) InArgs() []*Argument {
@@ -248,6 +360,19 @@ func (f *Function) InArgs() []*Argument {
 	return args
 }
 
+// OutArgs returns the arguments that make up the result of the function, the
+// OUT, INOUT and TABLE parameters
+func (f *Function) OutArgs() []*Argument {
+	var args []*Argument
+	for _, a := range f.Args {
+		switch a.Mode {
+		case ast.FuncParamOut, ast.FuncParamInOut, ast.FuncParamTable:
+			args = append(args, a)
+		}
+	}
+	return args
+}
+
 type Argument struct {
 	Name       string
 	Type       *ast.TypeName
@@ -267,14 +392,14 @@ func New(def string) *Catalog {
 
 func (c *Catalog) Build(stmts []ast.Statement) error {
 	for i := range stmts {
-		if err := c.Update(stmts[i]); err != nil {
+		if err := c.Update(stmts[i], nil); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *Catalog) Update(stmt ast.Statement) error {
+func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	if stmt.Raw == nil {
 		return nil
 	}
@@ -286,9 +411,21 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.AlterTableSetSchemaStmt:
 		err = c.alterTableSetSchema(n)
 
+	case *ast.AlterDomainStmt:
+		err = c.alterDomain(n)
+
+	case *ast.AlterSeqStmt:
+		err = c.alterSequence(n)
+
 	case *ast.AlterTypeAddValueStmt:
 		err = c.alterTypeAddValue(n)
 
+	case *ast.AlterTypeAttributesStmt:
+		err = c.alterTypeAttributes(n)
+
+	case *ast.AlterTypeRenameAttributeStmt:
+		err = c.alterTypeRenameAttribute(n)
+
 	case *ast.AlterTypeRenameValueStmt:
 		err = c.alterTypeRenameValue(n)
 
@@ -307,6 +444,9 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.CompositeTypeStmt:
 		err = c.createCompositeType(n)
 
+	case *ast.CreateDomainStmt:
+		err = c.createDomain(n)
+
 	case *ast.CreateEnumStmt:
 		err = c.createEnum(n)
 
@@ -319,30 +459,60 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.CreateSchemaStmt:
 		err = c.createSchema(n)
 
+	case *ast.CreateSeqStmt:
+		err = c.createSequence(n)
+
 	case *ast.CreateTableStmt:
 		err = c.createTable(n)
 
+	case *ast.CreateTableAsStmt:
+		err = c.createTableAs(n, colGen)
+
 	case *ast.DropFunctionStmt:
 		err = c.dropFunction(n)
 
+	case *ast.DropIndexStmt:
+		err = c.dropIndex(n)
+
 	case *ast.DropSchemaStmt:
 		err = c.dropSchema(n)
 
+	case *ast.DropSequenceStmt:
+		err = c.dropSequence(n)
+
 	case *ast.DropTableStmt:
 		err = c.dropTable(n)
 
 	case *ast.DropTypeStmt:
 		err = c.dropType(n)
 
+	case *ast.IndexStmt:
+		err = c.createIndex(n)
+
 	case *ast.RenameColumnStmt:
 		err = c.renameColumn(n)
 
+	case *ast.RenameConstraintStmt:
+		err = c.renameConstraint(n)
+
+	case *ast.RenameIndexStmt:
+		err = c.renameIndex(n)
+
+	case *ast.RenameSequenceStmt:
+		err = c.renameSequence(n)
+
 	case *ast.RenameTableStmt:
 		err = c.renameTable(n)
 
 	case *ast.RenameTypeStmt:
 		err = c.renameType(n)
 
+	case *ast.VariableSetStmt:
+		err = c.setSearchPath(n)
+
+	case *ast.ViewStmt:
+		err = c.createView(n, colGen)
+
 	}
 	return err
 }
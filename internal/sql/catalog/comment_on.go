@@ -50,7 +50,7 @@ func (c *Catalog) commentOnTable(stmt *ast.CommentOnTableStmt) error {
 }
 
 func (c *Catalog) commentOnType(stmt *ast.CommentOnTypeStmt) error {
-	t, _, err := c.getType(stmt.Type)
+	_, t, _, err := c.getType(stmt.Type)
 	if err != nil {
 		return err
 	}
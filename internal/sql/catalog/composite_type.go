@@ -0,0 +1,95 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+func (ct *CompositeType) column(name string) *Column {
+	for _, col := range ct.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) getCompositeType(name *ast.TypeName) (*CompositeType, error) {
+	_, typ, _, err := c.getType(name)
+	if err != nil {
+		return nil, err
+	}
+	ct, ok := typ.(*CompositeType)
+	if !ok {
+		return nil, fmt.Errorf("type is not a composite type: %s", name.Name)
+	}
+	return ct, nil
+}
+
+func (c *Catalog) alterTypeAttributes(stmt *ast.AlterTypeAttributesStmt) error {
+	ct, err := c.getCompositeType(stmt.Type)
+	if err != nil {
+		return err
+	}
+	for _, item := range stmt.Cmds.Items {
+		cmd, ok := item.(*ast.AlterTableCmd)
+		if !ok {
+			continue
+		}
+		switch cmd.Subtype {
+
+		case ast.AT_AddColumn:
+			if ct.column(cmd.Def.Colname) != nil {
+				return sqlerr.ColumnExists(ct.Name, cmd.Def.Colname)
+			}
+			ct.Columns = append(ct.Columns, &Column{
+				Name:    cmd.Def.Colname,
+				Type:    *c.QualifyType(cmd.Def.TypeName),
+				IsArray: cmd.Def.IsArray,
+			})
+
+		case ast.AT_AlterColumnType:
+			col := ct.column(*cmd.Name)
+			if col == nil {
+				return sqlerr.ColumnNotFound(ct.Name, *cmd.Name)
+			}
+			col.Type = *c.QualifyType(cmd.Def.TypeName)
+			col.IsArray = cmd.Def.IsArray
+
+		case ast.AT_DropColumn:
+			idx := -1
+			for i, col := range ct.Columns {
+				if col.Name == *cmd.Name {
+					idx = i
+				}
+			}
+			if idx < 0 && cmd.MissingOk {
+				continue
+			}
+			if idx < 0 {
+				return sqlerr.ColumnNotFound(ct.Name, *cmd.Name)
+			}
+			ct.Columns = append(ct.Columns[:idx], ct.Columns[idx+1:]...)
+
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) alterTypeRenameAttribute(stmt *ast.AlterTypeRenameAttributeStmt) error {
+	ct, err := c.getCompositeType(stmt.Type)
+	if err != nil {
+		return err
+	}
+	col := ct.column(*stmt.OldName)
+	if col == nil {
+		return sqlerr.ColumnNotFound(ct.Name, *stmt.OldName)
+	}
+	if ct.column(*stmt.NewName) != nil {
+		return sqlerr.ColumnExists(ct.Name, *stmt.NewName)
+	}
+	col.Name = *stmt.NewName
+	return nil
+}
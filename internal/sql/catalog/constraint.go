@@ -0,0 +1,392 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+func tableNameFromRangeVar(rv *ast.RangeVar) *ast.TableName {
+	rel := &ast.TableName{}
+	if rv.Catalogname != nil {
+		rel.Catalog = *rv.Catalogname
+	}
+	if rv.Schemaname != nil {
+		rel.Schema = *rv.Schemaname
+	}
+	if rv.Relname != nil {
+		rel.Name = *rv.Relname
+	}
+	return rel
+}
+
+func names(list *ast.List) []string {
+	if list == nil {
+		return nil
+	}
+	return stringSlice(list)
+}
+
+// exclusionColumns returns the columns of an exclusion constraint. Each
+// element pairs a column or expression with an operator.
+func exclusionColumns(con *ast.Constraint) []string {
+	var cols []string
+	if con.Exclusions == nil {
+		return cols
+	}
+	for _, item := range con.Exclusions.Items {
+		pair, ok := item.(*ast.List)
+		if !ok || len(pair.Items) == 0 {
+			continue
+		}
+		if elem, ok := pair.Items[0].(*ast.IndexElem); ok && elem.Name != nil {
+			cols = append(cols, *elem.Name)
+		}
+	}
+	return cols
+}
+
+// Foreign key actions are stored using the same letters as pg_constraint
+func foreignKeyAction(action byte) ForeignKeyAction {
+	switch action {
+	case 'r':
+		return ActionRestrict
+	case 'c':
+		return ActionCascade
+	case 'n':
+		return ActionSetNull
+	case 'd':
+		return ActionSetDefault
+	default:
+		return ActionNoAction
+	}
+}
+
+// checkColumns returns the columns referenced by a check constraint
+func checkColumns(con *ast.Constraint) []string {
+	if cols := names(con.Keys); len(cols) > 0 {
+		return cols
+	}
+	var cols []string
+	seen := map[string]bool{}
+	refs := astutils.Search(con.RawExpr, func(node ast.Node) bool {
+		_, ok := node.(*ast.ColumnRef)
+		return ok
+	})
+	for _, ref := range refs.Items {
+		fields := names(ref.(*ast.ColumnRef).Fields)
+		if len(fields) == 0 {
+			continue
+		}
+		name := fields[len(fields)-1]
+		if !seen[name] {
+			seen[name] = true
+			cols = append(cols, name)
+		}
+	}
+	return cols
+}
+
+// newConstraint converts a constraint from a CREATE TABLE or ALTER TABLE
+// statement. Constraints that are stored on columns, such as NOT NULL, result
+// in nil.
+func (c *Catalog) newConstraint(tbl *Table, con *ast.Constraint) (*Constraint, error) {
+	var constraint Constraint
+	switch con.Contype {
+	case ast.ConstrTypePrimary:
+		constraint.Type = ConstraintPrimaryKey
+		constraint.Columns = names(con.Keys)
+	case ast.ConstrTypeUnique:
+		constraint.Type = ConstraintUnique
+		constraint.Columns = names(con.Keys)
+	case ast.ConstrTypeForeign:
+		constraint.Type = ConstraintForeignKey
+		constraint.Columns = names(con.FkAttrs)
+		constraint.RefColumns = names(con.PkAttrs)
+		constraint.OnDelete = foreignKeyAction(con.FkDelAction)
+		constraint.OnUpdate = foreignKeyAction(con.FkUpdAction)
+		if con.Pktable == nil {
+			return nil, fmt.Errorf("foreign key on %s has no referenced table", tbl.Rel.Name)
+		}
+		constraint.RefTable = tableNameFromRangeVar(con.Pktable)
+	case ast.ConstrTypeCheck:
+		constraint.Type = ConstraintCheck
+		constraint.Columns = checkColumns(con)
+	case ast.ConstrTypeExclusion:
+		constraint.Type = ConstraintExclusion
+		constraint.Columns = exclusionColumns(con)
+	default:
+		return nil, nil
+	}
+
+	if constraint.Type != ConstraintCheck {
+		for _, name := range constraint.Columns {
+			if tbl.column(name) == nil {
+				return nil, sqlerr.ColumnNotFound(tbl.Rel.Name, name)
+			}
+		}
+	}
+
+	// A foreign key without a column list references the primary key
+	if constraint.Type == ConstraintForeignKey && len(constraint.RefColumns) == 0 {
+		ref := tbl
+		if constraint.RefTable.Name != tbl.Rel.Name || constraint.RefTable.Schema != tbl.Rel.Schema {
+			_, t, err := c.getTable(constraint.RefTable)
+			// An unqualified name may refer to the table that's being created
+			switch {
+			case err == nil:
+				ref = t
+			case constraint.RefTable.Name != tbl.Rel.Name || constraint.RefTable.Schema != "":
+				return nil, err
+			}
+		}
+		if pk := ref.PrimaryKey(); pk != nil {
+			constraint.RefColumns = append([]string{}, pk.Columns...)
+		}
+	}
+
+	if con.Conname != nil && *con.Conname != "" {
+		constraint.Name = *con.Conname
+		if tbl.constraint(constraint.Name) != nil {
+			return nil, sqlerr.ConstraintExists(tbl.Rel.Name, constraint.Name)
+		}
+	} else {
+		if c.NameConstraint != nil {
+			constraint.Name = c.NameConstraint(tbl, &constraint)
+		}
+		if constraint.Name == "" {
+			constraint.Name = tbl.constraintName(&constraint)
+		}
+	}
+	return &constraint, nil
+}
+
+// constraintName generates a name for an unnamed constraint, following the
+// PostgreSQL naming scheme
+func (t *Table) constraintName(con *Constraint) string {
+	var cols []string
+	var label string
+	switch con.Type {
+	case ConstraintPrimaryKey:
+		label = "pkey"
+	case ConstraintUnique:
+		cols = con.Columns
+		label = "key"
+	case ConstraintForeignKey:
+		cols = con.Columns
+		label = "fkey"
+	case ConstraintCheck:
+		if len(con.Columns) > 0 {
+			cols = con.Columns[:1]
+		}
+		label = "check"
+	case ConstraintExclusion:
+		cols = con.Columns
+		label = "excl"
+	}
+	name := objectName(t.Rel.Name, strings.Join(cols, "_"), label)
+	for i := 1; t.constraint(name) != nil; i++ {
+		name = objectName(t.Rel.Name, strings.Join(cols, "_"), fmt.Sprintf("%s%d", label, i))
+	}
+	return name
+}
+
+// maxIdentifierLength is the length that PostgreSQL truncates identifiers to
+const maxIdentifierLength = 63
+
+// objectName joins the parts of a generated name. Like PostgreSQL, the table
+// and column parts are shortened, longest first, until the name fits the
+// maximum identifier length.
+func objectName(table, cols, label string) string {
+	avail := maxIdentifierLength - len(label) - 1
+	if cols != "" {
+		avail--
+	}
+	t, c := len(table), len(cols)
+	for t+c > avail {
+		if t > c {
+			t--
+		} else {
+			c--
+		}
+	}
+	parts := []string{table[:t]}
+	if cols != "" {
+		parts = append(parts, cols[:c])
+	}
+	return strings.Join(append(parts, label), "_")
+}
+
+func (t *Table) column(name string) *Column {
+	for _, col := range t.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+func (t *Table) constraint(name string) *Constraint {
+	for _, con := range t.Constraints {
+		if con.Name == name {
+			return con
+		}
+	}
+	return nil
+}
+
+// PrimaryKey returns the primary key of the table, if it has one
+func (t *Table) PrimaryKey() *Constraint {
+	for _, con := range t.Constraints {
+		if con.Type == ConstraintPrimaryKey {
+			return con
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) addConstraint(tbl *Table, con *ast.Constraint) error {
+	constraint, err := c.newConstraint(tbl, con)
+	if err != nil || constraint == nil {
+		return err
+	}
+	if constraint.Type == ConstraintPrimaryKey {
+		if tbl.PrimaryKey() != nil {
+			return fmt.Errorf("multiple primary keys for table \"%s\" are not allowed", tbl.Rel.Name)
+		}
+		// Primary key columns are implicitly NOT NULL
+		for _, name := range constraint.Columns {
+			tbl.column(name).IsNotNull = true
+		}
+	}
+	tbl.Constraints = append(tbl.Constraints, constraint)
+	return nil
+}
+
+func (c *Catalog) dropConstraint(tbl *Table, name string, missingOk bool) error {
+	for i, con := range tbl.Constraints {
+		if con.Name == name {
+			tbl.Constraints = append(tbl.Constraints[:i], tbl.Constraints[i+1:]...)
+			return nil
+		}
+	}
+	if missingOk {
+		return nil
+	}
+	return sqlerr.ConstraintNotFound(tbl.Rel.Name, name)
+}
+
+func (c *Catalog) renameConstraint(stmt *ast.RenameConstraintStmt) error {
+	_, tbl, err := c.getTable(stmt.Table)
+	if err != nil {
+		return err
+	}
+	con := tbl.constraint(*stmt.Name)
+	if con == nil {
+		return sqlerr.ConstraintNotFound(tbl.Rel.Name, *stmt.Name)
+	}
+	if tbl.constraint(*stmt.NewName) != nil {
+		return sqlerr.ConstraintExists(tbl.Rel.Name, *stmt.NewName)
+	}
+	con.Name = *stmt.NewName
+	return nil
+}
+
+// dropColumnConstraints removes the constraints, indexes and sequences that
+// depend on a column that is being dropped
+func (c *Catalog) dropColumnConstraints(tbl *Table, column string) {
+	c.dropOwnedSequences(tbl, column)
+	var constraints []*Constraint
+	for _, con := range tbl.Constraints {
+		if !contains(con.Columns, column) {
+			constraints = append(constraints, con)
+		}
+	}
+	tbl.Constraints = constraints
+	for _, s := range c.Schemas {
+		var indexes []*Index
+		for _, idx := range s.Indexes {
+			if !sameTable(idx.Table, tbl.Rel) || !contains(idx.Columns, column) {
+				indexes = append(indexes, idx)
+			}
+		}
+		s.Indexes = indexes
+	}
+}
+
+// renameColumnReferences updates the constraints, indexes and sequences that
+// refer to a renamed column, including foreign keys in other tables
+func (c *Catalog) renameColumnReferences(tbl *Table, old, new string) {
+	rename := func(cols []string) {
+		for i := range cols {
+			if cols[i] == old {
+				cols[i] = new
+			}
+		}
+	}
+	for _, con := range tbl.Constraints {
+		rename(con.Columns)
+	}
+	for _, s := range c.Schemas {
+		for _, idx := range s.Indexes {
+			if sameTable(idx.Table, tbl.Rel) {
+				rename(idx.Columns)
+			}
+		}
+	}
+	for _, fk := range c.foreignKeysTo(tbl) {
+		rename(fk.RefColumns)
+	}
+	for _, s := range c.Schemas {
+		for _, seq := range s.Sequences {
+			if sameTable(seq.OwnedBy, tbl.Rel) && seq.OwnedByColumn == old {
+				seq.OwnedByColumn = new
+			}
+		}
+	}
+}
+
+// references reports whether a foreign key references the given table
+func (c *Catalog) references(con *Constraint, tbl *Table) bool {
+	if con.RefTable.Name != tbl.Rel.Name {
+		return false
+	}
+	if con.RefTable.Schema == "" {
+		// The referenced table is found on the search path
+		_, t, err := c.getTable(con.RefTable)
+		return err == nil && t == tbl
+	}
+	schema := tbl.Rel.Schema
+	if schema == "" {
+		schema = c.DefaultSchema
+	}
+	return con.RefTable.Schema == schema
+}
+
+// foreignKeysTo returns the foreign keys, in any table, that reference the
+// given table
+func (c *Catalog) foreignKeysTo(tbl *Table) []*Constraint {
+	var fks []*Constraint
+	for _, s := range c.Schemas {
+		for _, t := range s.Tables {
+			for _, con := range t.Constraints {
+				if con.Type == ConstraintForeignKey && c.references(con, tbl) {
+					fks = append(fks, con)
+				}
+			}
+		}
+	}
+	return fks
+}
+
+func contains(list []string, name string) bool {
+	for _, item := range list {
+		if item == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+func domainName(list *ast.List) (*ast.TypeName, error) {
+	parts := names(list)
+	switch len(parts) {
+	case 1:
+		return &ast.TypeName{Name: parts[0]}, nil
+	case 2:
+		return &ast.TypeName{Schema: parts[0], Name: parts[1]}, nil
+	default:
+		return nil, fmt.Errorf("invalid domain name: %v", parts)
+	}
+}
+
+func (c *Catalog) createDomain(stmt *ast.CreateDomainStmt) error {
+	name, err := domainName(stmt.Domainname)
+	if err != nil {
+		return err
+	}
+	schema, err := c.creationSchema(name.Schema)
+	if err != nil {
+		return err
+	}
+	// Domains share a namespace with tables and other types
+	if _, _, err := schema.getTable(&ast.TableName{Name: name.Name}); err == nil {
+		return sqlerr.RelationExists(name.Name)
+	}
+	if _, _, err := schema.getType(name); err == nil {
+		return sqlerr.TypeExists(name.Name)
+	}
+	base := c.QualifyType(stmt.TypeName)
+	domain := &Domain{
+		Name: name.Name,
+		BaseType: ast.TypeName{
+			Catalog: base.Catalog,
+			Schema:  base.Schema,
+			Name:    base.Name,
+		},
+		IsArray: stmt.TypeName.ArrayBounds != nil && len(stmt.TypeName.ArrayBounds.Items) > 0,
+		Default: stmt.Default,
+	}
+	if stmt.Constraints != nil {
+		for _, item := range stmt.Constraints.Items {
+			if con, ok := item.(*ast.Constraint); ok && con.Contype == ast.ConstrTypeNotNull {
+				domain.IsNotNull = true
+			}
+		}
+	}
+	schema.Types = append(schema.Types, domain)
+	return nil
+}
+
+func (c *Catalog) alterDomain(stmt *ast.AlterDomainStmt) error {
+	name, err := domainName(stmt.TypeName)
+	if err != nil {
+		return err
+	}
+	_, typ, _, err := c.getType(name)
+	if err != nil {
+		return err
+	}
+	domain, ok := typ.(*Domain)
+	if !ok {
+		return fmt.Errorf("%s is not a domain", name.Name)
+	}
+	// The subtypes are the same as those of PostgreSQL's AlterDomainStmt
+	switch stmt.Subtype {
+	case 'T':
+		domain.Default = stmt.Default
+	case 'N':
+		domain.IsNotNull = false
+	case 'O':
+		domain.IsNotNull = true
+	}
+	return nil
+}
+
+// isNotNullDomain reports whether a type is a domain that doesn't allow nulls
+func (c *Catalog) isNotNullDomain(typ *ast.TypeName) bool {
+	_, t, _, err := c.getType(typ)
+	if err != nil {
+		return false
+	}
+	domain, ok := t.(*Domain)
+	return ok && domain.IsNotNull
+}
@@ -19,11 +19,14 @@ func (c *Catalog) createExtension(stmt *ast.CreateExtensionStmt) error {
 	if ext == nil {
 		return nil
 	}
-	s, err := c.getSchema(c.DefaultSchema)
+	s, err := c.creationSchema("")
 	if err != nil {
 		return err
 	}
 	// TODO: Error on duplicate functions
+	for _, fn := range ext.Funcs {
+		fn.Extension = *stmt.Extname
+	}
 	s.Funcs = append(s.Funcs, ext.Funcs...)
 	return nil
 }
@@ -8,18 +8,16 @@ import (
 )
 
 func (c *Catalog) createFunction(stmt *ast.CreateFunctionStmt) error {
-	ns := stmt.Func.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	s, err := c.getSchema(ns)
+	s, err := c.creationSchema(stmt.Func.Schema)
 	if err != nil {
 		return err
 	}
 	fn := &Function{
-		Name:       stmt.Func.Name,
-		Args:       make([]*Argument, len(stmt.Params.Items)),
-		ReturnType: stmt.ReturnType,
+		Name:        stmt.Func.Name,
+		Args:        make([]*Argument, len(stmt.Params.Items)),
+		ReturnType:  c.QualifyType(stmt.ReturnType),
+		Strict:      isStrict(stmt.Options),
+		IsProcedure: stmt.IsProcedure,
 	}
 	types := make([]*ast.TypeName, len(stmt.Params.Items))
 	for i, item := range stmt.Params.Items {
@@ -30,11 +28,21 @@ func (c *Catalog) createFunction(stmt *ast.CreateFunctionStmt) error {
 		}
 		fn.Args[i] = &Argument{
 			Name:       name,
-			Type:       arg.Type,
+			Type:       c.QualifyType(arg.Type),
 			Mode:       arg.Mode,
 			HasDefault: arg.DefExpr != nil,
 		}
-		types[i] = arg.Type
+		types[i] = fn.Args[i].Type
+	}
+	// Functions with OUT parameters return a record, unless there's only one
+	if fn.ReturnType == nil && !fn.IsProcedure {
+		switch out := fn.OutArgs(); len(out) {
+		case 0:
+		case 1:
+			fn.ReturnType = out[0].Type
+		default:
+			fn.ReturnType = &ast.TypeName{Schema: "pg_catalog", Name: "record"}
+		}
 	}
 
 	_, idx, err := s.getFunc(stmt.Func, types)
@@ -52,21 +60,29 @@ func (c *Catalog) createFunction(stmt *ast.CreateFunctionStmt) error {
 
 func (c *Catalog) dropFunction(stmt *ast.DropFunctionStmt) error {
 	for _, spec := range stmt.Funcs {
-		ns := spec.Name.Schema
-		if ns == "" {
-			ns = c.DefaultSchema
-		}
-		s, err := c.getSchema(ns)
+		schemas, err := c.schemasFor(spec.Name.Schema)
 		if errors.Is(err, sqlerr.NotFound) && stmt.MissingOk {
 			continue
 		} else if err != nil {
 			return err
 		}
-		var idx int
-		if spec.HasArgs {
-			_, idx, err = s.getFunc(spec.Name, spec.Args)
-		} else {
-			_, idx, err = s.getFuncByName(spec.Name)
+		args := make([]*ast.TypeName, len(spec.Args))
+		for i, arg := range spec.Args {
+			args[i] = c.QualifyType(arg)
+		}
+		var s *Schema
+		idx := -1
+		err = sqlerr.RelationNotFound(spec.Name.Name)
+		for _, schema := range schemas {
+			if spec.HasArgs {
+				_, idx, err = schema.getFunc(spec.Name, args)
+			} else {
+				_, idx, err = schema.getFuncByName(spec.Name)
+			}
+			if !errors.Is(err, sqlerr.NotFound) {
+				s = schema
+				break
+			}
 		}
 		if errors.Is(err, sqlerr.NotFound) && stmt.MissingOk {
 			continue
@@ -77,3 +93,20 @@ func (c *Catalog) dropFunction(stmt *ast.DropFunctionStmt) error {
 	}
 	return nil
 }
+
+// STRICT and RETURNS NULL ON NULL INPUT are both stored as a "strict" option
+func isStrict(options *ast.List) bool {
+	if options == nil {
+		return false
+	}
+	for _, item := range options.Items {
+		opt, ok := item.(*ast.DefElem)
+		if !ok || opt.Defname == nil || *opt.Defname != "strict" {
+			continue
+		}
+		if v, ok := opt.Arg.(*ast.Integer); ok {
+			return v.Ival != 0
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+func (s *Schema) getIndex(name string, table *ast.TableName) (*Index, int, error) {
+	for i, idx := range s.Indexes {
+		if idx.Name != name {
+			continue
+		}
+		if table != nil && idx.Table.Name != table.Name {
+			continue
+		}
+		return idx, i, nil
+	}
+	return nil, -1, sqlerr.IndexNotFound(name)
+}
+
+// indexName generates a name for an unnamed index, following the PostgreSQL
+// naming scheme. Indexes share a namespace with tables.
+func (s *Schema) indexName(tbl *Table, cols []string) string {
+	var parts []string
+	for _, col := range cols {
+		if col == "" {
+			col = "expr"
+		}
+		parts = append(parts, col)
+	}
+	name := objectName(tbl.Rel.Name, strings.Join(parts, "_"), "idx")
+	for i := 1; s.hasRelation(name); i++ {
+		name = objectName(tbl.Rel.Name, strings.Join(parts, "_"), fmt.Sprintf("idx%d", i))
+	}
+	return name
+}
+
+func (s *Schema) hasRelation(name string) bool {
+	if _, _, err := s.getTable(&ast.TableName{Name: name}); err == nil {
+		return true
+	}
+	if _, _, err := s.getSequence(name); err == nil {
+		return true
+	}
+	_, _, err := s.getIndex(name, nil)
+	return err == nil
+}
+
+func (c *Catalog) createIndex(stmt *ast.IndexStmt) error {
+	if stmt.Relation == nil {
+		return errors.New("create index: missing table")
+	}
+	schema, tbl, err := c.getTable(tableNameFromRangeVar(stmt.Relation))
+	if err != nil {
+		return err
+	}
+	var cols []string
+	if stmt.IndexParams != nil {
+		for _, item := range stmt.IndexParams.Items {
+			elem, ok := item.(*ast.IndexElem)
+			if !ok {
+				continue
+			}
+			if elem.Name == nil {
+				cols = append(cols, "")
+				continue
+			}
+			if tbl.column(*elem.Name) == nil {
+				return sqlerr.ColumnNotFound(tbl.Rel.Name, *elem.Name)
+			}
+			cols = append(cols, *elem.Name)
+		}
+	}
+	var name string
+	if stmt.Idxname != nil && *stmt.Idxname != "" {
+		name = *stmt.Idxname
+		_, _, err := schema.getIndex(name, tbl.Rel)
+		if _, _, terr := schema.getTable(&ast.TableName{Name: name}); err == nil || terr == nil {
+			if stmt.IfNotExists {
+				return nil
+			}
+			return sqlerr.RelationExists(name)
+		}
+	} else {
+		name = schema.indexName(tbl, cols)
+	}
+	schema.Indexes = append(schema.Indexes, &Index{
+		Name:    name,
+		Table:   tbl.Rel,
+		Columns: cols,
+		Unique:  stmt.Unique,
+	})
+	return nil
+}
+
+func (c *Catalog) indexSchema(name *ast.TableName, table *ast.TableName) (*Schema, error) {
+	if table != nil {
+		schema, _, err := c.getTable(table)
+		return schema, err
+	}
+	schemas, err := c.schemasFor(name.Schema)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schemas {
+		if _, _, err := s.getIndex(name.Name, nil); err == nil {
+			return s, nil
+		}
+	}
+	if len(schemas) == 0 {
+		return nil, sqlerr.IndexNotFound(name.Name)
+	}
+	return schemas[0], nil
+}
+
+func (c *Catalog) dropIndex(stmt *ast.DropIndexStmt) error {
+	for _, name := range stmt.Indexes {
+		schema, err := c.indexSchema(name, stmt.Table)
+		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+			continue
+		} else if err != nil {
+			return err
+		}
+		_, idx, err := schema.getIndex(name.Name, stmt.Table)
+		if err == nil {
+			schema.Indexes = append(schema.Indexes[:idx], schema.Indexes[idx+1:]...)
+			continue
+		}
+		// MySQL also drops primary keys and unique constraints by index name
+		if stmt.Table != nil {
+			_, tbl, terr := c.getTable(stmt.Table)
+			if terr != nil {
+				return terr
+			}
+			if tbl.constraint(name.Name) != nil {
+				if err := c.dropConstraint(tbl, name.Name, false); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+			continue
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *Catalog) renameIndex(stmt *ast.RenameIndexStmt) error {
+	schema, err := c.indexSchema(stmt.Index, stmt.Table)
+	if err != nil {
+		return err
+	}
+	index, _, err := schema.getIndex(stmt.Index.Name, stmt.Table)
+	if err != nil {
+		if stmt.Table == nil {
+			return err
+		}
+		// MySQL also renames unique constraints by index name
+		_, tbl, terr := c.getTable(stmt.Table)
+		if terr != nil {
+			return terr
+		}
+		con := tbl.constraint(stmt.Index.Name)
+		if con == nil || con.Type == ConstraintForeignKey || con.Type == ConstraintCheck {
+			return err
+		}
+		if tbl.constraint(*stmt.NewName) != nil {
+			return sqlerr.ConstraintExists(tbl.Rel.Name, *stmt.NewName)
+		}
+		con.Name = *stmt.NewName
+		return nil
+	}
+	if _, _, err := schema.getIndex(*stmt.NewName, stmt.Table); err == nil {
+		return sqlerr.RelationExists(*stmt.NewName)
+	}
+	index.Name = *stmt.NewName
+	return nil
+}
+
+// sameTable reports whether two names refer to the same table of a schema
+func sameTable(a, b *ast.TableName) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Schema == b.Schema && a.Name == b.Name
+}
+
+// dropTableIndexes removes the indexes on a table that is being dropped
+func (s *Schema) dropTableIndexes(tbl *Table) {
+	var indexes []*Index
+	for _, idx := range s.Indexes {
+		if !sameTable(idx.Table, tbl.Rel) {
+			indexes = append(indexes, idx)
+		}
+	}
+	s.Indexes = indexes
+}
@@ -0,0 +1,295 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// IsPartition reports whether the table is a partition of a partitioned table
+func (t *Table) IsPartition() bool {
+	return t.PartitionBound != nil
+}
+
+// Parent tables are stored using the Rel of the parent, so that they follow
+// renames. They're found by identity rather than by name.
+func (c *Catalog) tableByRel(rel *ast.TableName) *Table {
+	for _, s := range c.Schemas {
+		for _, t := range s.Tables {
+			if t.Rel == rel {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+// children returns the tables that directly inherit from a table, including
+// its partitions
+func (c *Catalog) children(tbl *Table) []*Table {
+	var tables []*Table
+	for _, s := range c.Schemas {
+		for _, t := range s.Tables {
+			for _, parent := range t.Inherits {
+				if parent == tbl.Rel {
+					tables = append(tables, t)
+					break
+				}
+			}
+		}
+	}
+	return tables
+}
+
+// parentHasColumn reports whether any of the table's parents has the column
+func (c *Catalog) parentHasColumn(tbl *Table, name string) bool {
+	for _, rel := range tbl.Inherits {
+		if parent := c.tableByRel(rel); parent != nil && parent.column(name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func sameColumnType(col *Column, typ *ast.TypeName, isArray bool) bool {
+	return sameType(&col.Type, typ) && col.IsArray == isArray
+}
+
+func typeConflict(name string, inherited bool) *sqlerr.Error {
+	msg := fmt.Sprintf("column \"%s\" has a type conflict", name)
+	if inherited {
+		msg = "inherited " + msg
+	}
+	return &sqlerr.Error{Code: "42804", Message: msg}
+}
+
+func notPartitioned(tbl *Table) *sqlerr.Error {
+	return &sqlerr.Error{
+		Code:    "42809",
+		Message: fmt.Sprintf("table \"%s\" is not partitioned", tbl.Rel.Name),
+	}
+}
+
+func partitionedParent(tbl *Table) *sqlerr.Error {
+	return &sqlerr.Error{
+		Code:    "42809",
+		Message: fmt.Sprintf("cannot inherit from partitioned table \"%s\"", tbl.Rel.Name),
+	}
+}
+
+// inheritColumns adds the columns of a new table's parents, merging columns
+// that more than one parent has
+func (c *Catalog) inheritColumns(tbl *Table, stmt *ast.CreateTableStmt) error {
+	for _, name := range stmt.Inherits {
+		_, parent, err := c.getTable(name)
+		if err != nil {
+			return err
+		}
+		if stmt.PartitionBound != nil && parent.PartitionKey == nil {
+			return notPartitioned(parent)
+		}
+		if stmt.PartitionBound == nil && parent.PartitionKey != nil {
+			return partitionedParent(parent)
+		}
+		for _, col := range parent.Columns {
+			if existing := tbl.column(col.Name); existing != nil {
+				if !sameColumnType(existing, &col.Type, col.IsArray) {
+					return typeConflict(col.Name, true)
+				}
+				existing.IsNotNull = existing.IsNotNull || col.IsNotNull
+				continue
+			}
+			inherited := *col
+			inherited.Comment = ""
+			inherited.Inherited = true
+			tbl.Columns = append(tbl.Columns, &inherited)
+		}
+		tbl.Inherits = append(tbl.Inherits, parent.Rel)
+	}
+	tbl.PartitionBound = stmt.PartitionBound
+	return nil
+}
+
+// mergeColumn applies a column definition to a column the table inherits.
+// Partitions only add options, such as NOT NULL, to their columns.
+func mergeColumn(col *Column, def *ast.ColumnDef) error {
+	if def.TypeName != nil {
+		if !sameColumnType(col, def.TypeName, def.IsArray) {
+			return typeConflict(col.Name, false)
+		}
+		col.Inherited = false
+	}
+	col.IsNotNull = col.IsNotNull || def.IsNotNull
+	if def.Default != "" {
+		col.Default = def.Default
+	}
+	if def.Comment != "" {
+		col.Comment = def.Comment
+	}
+	return nil
+}
+
+// checkChildColumns verifies that a table has the columns of the parent it's
+// being attached to. A partition can't have any other columns.
+func checkChildColumns(parent, child *Table) error {
+	for _, col := range parent.Columns {
+		cc := child.column(col.Name)
+		if cc == nil {
+			return &sqlerr.Error{
+				Code:    "42804",
+				Message: fmt.Sprintf("child table is missing column \"%s\"", col.Name),
+			}
+		}
+		if !sameColumnType(cc, &col.Type, col.IsArray) {
+			return &sqlerr.Error{
+				Code:    "42804",
+				Message: fmt.Sprintf("child table \"%s\" has different type for column \"%s\"", child.Rel.Name, col.Name),
+			}
+		}
+	}
+	if parent.PartitionKey == nil {
+		return nil
+	}
+	for _, col := range child.Columns {
+		if parent.column(col.Name) == nil {
+			return &sqlerr.Error{
+				Code:    "42804",
+				Message: fmt.Sprintf("table \"%s\" contains column \"%s\" not found in parent \"%s\"", child.Rel.Name, col.Name, parent.Rel.Name),
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) attachPartition(parent *Table, cmd *ast.AlterTableCmd) error {
+	if parent.PartitionKey == nil {
+		return notPartitioned(parent)
+	}
+	_, part, err := c.getTable(cmd.Relation)
+	if err != nil {
+		return err
+	}
+	if len(part.Inherits) > 0 {
+		return &sqlerr.Error{
+			Code:    "55000",
+			Message: fmt.Sprintf("\"%s\" is already a child of another relation", part.Rel.Name),
+		}
+	}
+	if err := checkChildColumns(parent, part); err != nil {
+		return err
+	}
+	for _, col := range part.Columns {
+		col.Inherited = true
+	}
+	part.Inherits = []*ast.TableName{parent.Rel}
+	part.PartitionBound = cmd.PartitionBound
+	return nil
+}
+
+func (c *Catalog) detachPartition(parent *Table, cmd *ast.AlterTableCmd) error {
+	_, part, err := c.getTable(cmd.Relation)
+	if err != nil {
+		return err
+	}
+	if !part.IsPartition() || part.Inherits[0] != parent.Rel {
+		return &sqlerr.Error{
+			Code:    "42P01",
+			Message: fmt.Sprintf("relation \"%s\" is not a partition of relation \"%s\"", part.Rel.Name, parent.Rel.Name),
+		}
+	}
+	c.removeParent(part, parent.Rel)
+	part.PartitionBound = nil
+	return nil
+}
+
+func (c *Catalog) addInherit(child *Table, name *ast.TableName) error {
+	if child.IsPartition() {
+		return &sqlerr.Error{Code: "42809", Message: "cannot change inheritance of a partition"}
+	}
+	_, parent, err := c.getTable(name)
+	if err != nil {
+		return err
+	}
+	if parent.PartitionKey != nil {
+		return partitionedParent(parent)
+	}
+	for _, rel := range child.Inherits {
+		if rel == parent.Rel {
+			return &sqlerr.Error{
+				Code:    "42P07",
+				Message: fmt.Sprintf("relation \"%s\" would be inherited from more than once", parent.Rel.Name),
+			}
+		}
+	}
+	if err := checkChildColumns(parent, child); err != nil {
+		return err
+	}
+	child.Inherits = append(child.Inherits, parent.Rel)
+	return nil
+}
+
+func (c *Catalog) dropInherit(child *Table, name *ast.TableName) error {
+	if child.IsPartition() {
+		return &sqlerr.Error{Code: "42809", Message: "cannot change inheritance of a partition"}
+	}
+	_, parent, err := c.getTable(name)
+	if err != nil {
+		return err
+	}
+	if !c.removeParent(child, parent.Rel) {
+		return &sqlerr.Error{
+			Code:    "42P01",
+			Message: fmt.Sprintf("relation \"%s\" is not a parent of relation \"%s\"", parent.Rel.Name, child.Rel.Name),
+		}
+	}
+	return nil
+}
+
+// removeParent removes a parent from a table. The columns that the table no
+// longer inherits from any parent become its own.
+func (c *Catalog) removeParent(child *Table, rel *ast.TableName) bool {
+	for i, parent := range child.Inherits {
+		if parent != rel {
+			continue
+		}
+		child.Inherits = append(child.Inherits[:i], child.Inherits[i+1:]...)
+		for _, col := range child.Columns {
+			if col.Inherited && !c.parentHasColumn(child, col.Name) {
+				col.Inherited = false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// dropChildren runs before a table is dropped. Its partitions are dropped with
+// it, while tables that inherit from it lose it as a parent.
+func (c *Catalog) dropChildren(tbl *Table) error {
+	for _, child := range c.children(tbl) {
+		if !child.IsPartition() {
+			c.removeParent(child, tbl.Rel)
+			continue
+		}
+		schema, err := c.schemaOf(child)
+		if err != nil {
+			return err
+		}
+		if err := c.dropTableFrom(schema, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) schemaOf(tbl *Table) (*Schema, error) {
+	for _, s := range c.Schemas {
+		for _, t := range s.Tables {
+			if t == tbl {
+				return s, nil
+			}
+		}
+	}
+	return nil, sqlerr.RelationNotFound(tbl.Rel.Name)
+}
@@ -8,21 +8,14 @@ import (
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 )
 
-func (c *Catalog) schemasToSearch(ns string) []string {
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	return append(c.SearchPath, ns)
-}
-
 func (c *Catalog) ListFuncsByName(rel *ast.FuncName) ([]Function, error) {
 	var funcs []Function
 	lowered := strings.ToLower(rel.Name)
-	for _, ns := range c.schemasToSearch(rel.Schema) {
-		s, err := c.getSchema(ns)
-		if err != nil {
-			return nil, err
-		}
+	schemas, err := c.schemasFor(rel.Schema)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schemas {
 		for i := range s.Funcs {
 			if strings.ToLower(s.Funcs[i].Name) == lowered {
 				funcs = append(funcs, *s.Funcs[i])
@@ -131,3 +124,43 @@ func (c *Catalog) GetTable(rel *ast.TableName) (Table, error) {
 		return *table, err
 	}
 }
+
+// PartitionRoot returns the partitioned table at the top of a partition's
+// hierarchy. Any other table is returned as is.
+func (c *Catalog) PartitionRoot(rel *ast.TableName) *ast.TableName {
+	if rel == nil {
+		return nil
+	}
+	_, tbl, err := c.getTable(rel)
+	if err != nil || !tbl.IsPartition() {
+		return rel
+	}
+	for tbl.IsPartition() {
+		parent := c.tableByRel(tbl.Inherits[0])
+		if parent == nil {
+			break
+		}
+		tbl = parent
+	}
+	schema, err := c.schemaOf(tbl)
+	if err != nil {
+		return rel
+	}
+	return &ast.TableName{Schema: schema.Name, Name: tbl.Rel.Name}
+}
+
+func (c *Catalog) GetSequence(rel *ast.TableName) (Sequence, error) {
+	_, seq, _, err := c.getSequence(rel)
+	if seq == nil {
+		return Sequence{}, err
+	}
+	return *seq, nil
+}
+
+func (c *Catalog) GetCompositeType(name *ast.TypeName) (CompositeType, error) {
+	ct, err := c.getCompositeType(name)
+	if err != nil {
+		return CompositeType{}, err
+	}
+	return *ct, nil
+}
@@ -0,0 +1,128 @@
+package catalog
+
+import (
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+func (c *Catalog) userSearchPath() []string {
+	if c.SearchPath == nil {
+		return []string{c.DefaultSchema}
+	}
+	return c.SearchPath
+}
+
+// searchPath returns the schemas that unqualified names are looked up in, in
+// order
+func (c *Catalog) searchPath() []string {
+	path := c.userSearchPath()
+	if c.SystemSchema == "" {
+		return path
+	}
+	for _, ns := range path {
+		if ns == c.SystemSchema {
+			return path
+		}
+	}
+	return append([]string{c.SystemSchema}, path...)
+}
+
+// schemasFor returns the schemas to look for an object in. A qualified name
+// has to refer to an existing schema, while the schemas of the search path
+// that don't exist are skipped.
+func (c *Catalog) schemasFor(ns string) ([]*Schema, error) {
+	if ns != "" {
+		s, err := c.getSchema(ns)
+		if err != nil {
+			return nil, err
+		}
+		return []*Schema{s}, nil
+	}
+	var schemas []*Schema
+	for _, name := range c.searchPath() {
+		if s, err := c.getSchema(name); err == nil {
+			schemas = append(schemas, s)
+		}
+	}
+	return schemas, nil
+}
+
+// creationSchema returns the schema that a new object is created in. Objects
+// with unqualified names go into the first schema of the search path that
+// exists.
+func (c *Catalog) creationSchema(ns string) (*Schema, error) {
+	if ns != "" {
+		return c.getSchema(ns)
+	}
+	for _, name := range c.userSearchPath() {
+		if s, err := c.getSchema(name); err == nil {
+			return s, nil
+		}
+	}
+	return nil, &sqlerr.Error{
+		Code:    "3F000",
+		Message: "no schema has been selected to create in",
+	}
+}
+
+// qualify returns the name that an object created in a schema is known by.
+// Only objects outside of the default schema are qualified, so that the
+// names in the default schema stay the same no matter how they were created.
+func (c *Catalog) qualify(rel *ast.TableName, s *Schema) *ast.TableName {
+	if rel.Schema != "" || s.Name == c.DefaultSchema {
+		return rel
+	}
+	return &ast.TableName{Catalog: rel.Catalog, Schema: s.Name, Name: rel.Name}
+}
+
+// QualifyType qualifies a type name with the schema that it resolves to,
+// unless that's the default or system schema. Tables are types too.
+func (c *Catalog) QualifyType(tn *ast.TypeName) *ast.TypeName {
+	if tn == nil || tn.Schema != "" {
+		return tn
+	}
+	s, _, _, err := c.getType(tn)
+	if err != nil {
+		s, _, err = c.getTable(&ast.TableName{Name: tn.Name})
+	}
+	if err != nil || s.Name == c.DefaultSchema || s.Name == c.SystemSchema {
+		return tn
+	}
+	qualified := *tn
+	qualified.Schema = s.Name
+	return &qualified
+}
+
+func (c *Catalog) setSearchPath(stmt *ast.VariableSetStmt) error {
+	if stmt.Name == nil || strings.ToLower(*stmt.Name) != "search_path" {
+		return nil
+	}
+	switch stmt.Kind {
+	case ast.VAR_SET_DEFAULT, ast.VAR_RESET:
+		c.SearchPath = nil
+	case ast.VAR_SET_VALUE:
+		// An empty search path leaves nothing to create objects in
+		path := []string{}
+		if stmt.Args != nil {
+			for _, arg := range stmt.Args.Items {
+				con, ok := arg.(*ast.A_Const)
+				if !ok {
+					continue
+				}
+				str, ok := con.Val.(*ast.String)
+				if !ok {
+					continue
+				}
+				// There are no roles to match "$user" against
+				if str.Str == "" || str.Str == "$user" {
+					continue
+				}
+				path = append(path, str.Str)
+			}
+		}
+		c.SearchPath = path
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// Serial columns are backed by a sequence of the matching integer type
+var serialTypes = map[string]string{
+	"smallserial": "int2",
+	"serial2":     "int2",
+	"serial":      "int4",
+	"serial4":     "int4",
+	"bigserial":   "int8",
+	"serial8":     "int8",
+}
+
+func (s *Schema) getSequence(name string) (*Sequence, int, error) {
+	for i, seq := range s.Sequences {
+		if seq.Name == name {
+			return seq, i, nil
+		}
+	}
+	return nil, -1, sqlerr.SequenceNotFound(name)
+}
+
+func (c *Catalog) getSequence(name *ast.TableName) (*Schema, *Sequence, int, error) {
+	schemas, err := c.schemasFor(name.Schema)
+	if err != nil {
+		return nil, nil, -1, err
+	}
+	for _, schema := range schemas {
+		if seq, idx, err := schema.getSequence(name.Name); err == nil {
+			return schema, seq, idx, nil
+		}
+	}
+	return nil, nil, -1, sqlerr.SequenceNotFound(name.Name)
+}
+
+func sequenceTypeName(tn *ast.TypeName) ast.TypeName {
+	if tn.Name != "" {
+		return *tn
+	}
+	var typ ast.TypeName
+	parts := stringSlice(tn.Names)
+	switch len(parts) {
+	case 1:
+		typ.Name = parts[0]
+	case 2:
+		typ.Schema = parts[0]
+		typ.Name = parts[1]
+	}
+	return typ
+}
+
+func (c *Catalog) setSequenceOptions(seq *Sequence, options *ast.List) error {
+	if options == nil {
+		return nil
+	}
+	for _, item := range options.Items {
+		opt, ok := item.(*ast.DefElem)
+		if !ok || opt.Defname == nil {
+			continue
+		}
+		switch *opt.Defname {
+
+		case "as":
+			if tn, ok := opt.Arg.(*ast.TypeName); ok {
+				seq.Type = sequenceTypeName(tn)
+			}
+
+		case "owned_by":
+			list, ok := opt.Arg.(*ast.List)
+			if !ok {
+				continue
+			}
+			parts := stringSlice(list)
+			if len(parts) == 1 && parts[0] == "none" {
+				seq.OwnedBy = nil
+				seq.OwnedByColumn = ""
+				continue
+			}
+			if len(parts) < 2 {
+				continue
+			}
+			rel := &ast.TableName{Name: parts[len(parts)-2]}
+			if len(parts) > 2 {
+				rel.Schema = parts[len(parts)-3]
+			}
+			_, tbl, err := c.getTable(rel)
+			if err != nil {
+				return err
+			}
+			col := parts[len(parts)-1]
+			if tbl.column(col) == nil {
+				return sqlerr.ColumnNotFound(tbl.Rel.Name, col)
+			}
+			seq.OwnedBy = tbl.Rel
+			seq.OwnedByColumn = col
+
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) createSequence(stmt *ast.CreateSeqStmt) error {
+	name := tableNameFromRangeVar(stmt.Sequence)
+	schema, err := c.creationSchema(name.Schema)
+	if err != nil {
+		return err
+	}
+	if schema.hasRelation(name.Name) {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return sqlerr.RelationExists(name.Name)
+	}
+	seq := &Sequence{
+		Name: name.Name,
+		Type: ast.TypeName{Name: "int8"},
+	}
+	if err := c.setSequenceOptions(seq, stmt.Options); err != nil {
+		return err
+	}
+	schema.Sequences = append(schema.Sequences, seq)
+	return nil
+}
+
+func (c *Catalog) alterSequence(stmt *ast.AlterSeqStmt) error {
+	_, seq, _, err := c.getSequence(tableNameFromRangeVar(stmt.Sequence))
+	if errors.Is(err, sqlerr.NotFound) && stmt.MissingOk {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return c.setSequenceOptions(seq, stmt.Options)
+}
+
+func (c *Catalog) dropSequence(stmt *ast.DropSequenceStmt) error {
+	for _, name := range stmt.Sequences {
+		schema, _, idx, err := c.getSequence(name)
+		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+			continue
+		} else if err != nil {
+			return err
+		}
+		schema.Sequences = append(schema.Sequences[:idx], schema.Sequences[idx+1:]...)
+	}
+	return nil
+}
+
+func (c *Catalog) renameSequence(stmt *ast.RenameSequenceStmt) error {
+	schema, seq, _, err := c.getSequence(stmt.Sequence)
+	if errors.Is(err, sqlerr.NotFound) && stmt.MissingOk {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if schema.hasRelation(*stmt.NewName) {
+		return sqlerr.RelationExists(*stmt.NewName)
+	}
+	seq.Name = *stmt.NewName
+	return nil
+}
+
+// createColumnSequence creates the implicit sequence of a serial or identity
+// column, named like PostgreSQL does
+func (s *Schema) createColumnSequence(tbl *Table, col *Column) {
+	typ, serial := serialTypes[col.Type.Name]
+	if !serial && col.Identity == 0 {
+		return
+	}
+	seq := &Sequence{
+		Name:          s.sequenceName(tbl, col),
+		Type:          col.Type,
+		OwnedBy:       tbl.Rel,
+		OwnedByColumn: col.Name,
+	}
+	if serial {
+		seq.Type = ast.TypeName{Name: typ}
+	}
+	s.Sequences = append(s.Sequences, seq)
+}
+
+func (s *Schema) sequenceName(tbl *Table, col *Column) string {
+	base := tbl.Rel.Name + "_" + col.Name + "_seq"
+	name := base
+	for i := 1; s.hasRelation(name); i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	return name
+}
+
+// dropOwnedSequences removes the sequences owned by a table, or by one of its
+// columns if column is set
+func (c *Catalog) dropOwnedSequences(tbl *Table, column string) {
+	for _, s := range c.Schemas {
+		var sequences []*Sequence
+		for _, seq := range s.Sequences {
+			if !sameTable(seq.OwnedBy, tbl.Rel) || (column != "" && seq.OwnedByColumn != column) {
+				sequences = append(sequences, seq)
+			}
+		}
+		s.Sequences = sequences
+	}
+}
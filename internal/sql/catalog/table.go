@@ -24,72 +24,246 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 				implemented = true
 			case ast.AT_SetNotNull:
 				implemented = true
+			case ast.AT_AddConstraint:
+				implemented = true
+			case ast.AT_DropConstraint:
+				implemented = true
+			case ast.AT_AddIndex:
+				implemented = true
+			case ast.AT_DropIndex:
+				implemented = true
+			case ast.AT_AttachPartition, ast.AT_DetachPartition:
+				implemented = true
+			case ast.AT_AddInherit, ast.AT_DropInherit:
+				implemented = true
+			case ast.AT_ColumnDefault, ast.AT_RenameColumn:
+				implemented = true
+			case ast.AT_AddIdentity, ast.AT_DropIdentity, ast.AT_DropExpression:
+				implemented = true
 			}
 		}
 	}
 	if !implemented {
 		return nil
 	}
-	_, table, err := c.getTable(stmt.Table)
+	schema, table, err := c.getTable(stmt.Table)
 	if err != nil {
 		return err
 	}
 
-	for _, cmd := range stmt.Cmds.Items {
-		switch cmd := cmd.(type) {
-		case *ast.AlterTableCmd:
-			idx := -1
+	for _, item := range stmt.Cmds.Items {
+		cmd, ok := item.(*ast.AlterTableCmd)
+		if !ok {
+			continue
+		}
+		if err := c.alterTableCmd(schema, table, cmd, false); err != nil {
+			return err
+		}
+	}
 
-			// Lookup column names for column-related commands
-			switch cmd.Subtype {
-			case ast.AT_AlterColumnType,
-				ast.AT_DropColumn,
-				ast.AT_DropNotNull,
-				ast.AT_SetNotNull:
-				for i, c := range table.Columns {
-					if c.Name == *cmd.Name {
-						idx = i
-						break
-					}
-				}
-				if idx < 0 && !cmd.MissingOk {
-					return sqlerr.ColumnNotFound(table.Rel.Name, *cmd.Name)
-				}
-				// If a missing column is allowed, skip this command
-				if idx < 0 && cmd.MissingOk {
-					continue
-				}
+	return nil
+}
+
+// alterTableCmd applies a command to a table. Changes to columns also apply to
+// the tables that inherit them, in which case inherited is set.
+func (c *Catalog) alterTableCmd(schema *Schema, table *Table, cmd *ast.AlterTableCmd, inherited bool) error {
+	idx := -1
+
+	// Lookup column names for column-related commands
+	switch cmd.Subtype {
+	case ast.AT_AlterColumnType,
+		ast.AT_DropColumn,
+		ast.AT_DropNotNull,
+		ast.AT_SetNotNull,
+		ast.AT_ColumnDefault,
+		ast.AT_AddIdentity,
+		ast.AT_DropIdentity,
+		ast.AT_DropExpression,
+		ast.AT_RenameColumn:
+		for i, c := range table.Columns {
+			if c.Name == *cmd.Name {
+				idx = i
+				break
 			}
+		}
+		// IF EXISTS only refers to the column when it's dropped
+		missingOk := cmd.MissingOk && cmd.Subtype == ast.AT_DropColumn
+		if idx < 0 && !missingOk && !inherited {
+			return sqlerr.ColumnNotFound(table.Rel.Name, *cmd.Name)
+		}
+		// If a missing column is allowed, skip this command
+		if idx < 0 {
+			return nil
+		}
+	}
 
-			switch cmd.Subtype {
+	switch cmd.Subtype {
 
-			case ast.AT_AddColumn:
-				for _, c := range table.Columns {
-					if c.Name == cmd.Def.Colname {
-						return sqlerr.ColumnExists(table.Rel.Name, c.Name)
-					}
-				}
-				table.Columns = append(table.Columns, &Column{
-					Name:      cmd.Def.Colname,
-					Type:      *cmd.Def.TypeName,
-					IsNotNull: cmd.Def.IsNotNull,
-					IsArray:   cmd.Def.IsArray,
-					Length:    cmd.Def.Length,
-				})
+	case ast.AT_AddColumn:
+		if col := table.column(cmd.Def.Colname); col != nil {
+			// ADD COLUMN IF NOT EXISTS leaves the column as it is
+			if cmd.MissingOk && !inherited {
+				return nil
+			}
+			if !inherited {
+				return sqlerr.ColumnExists(table.Rel.Name, col.Name)
+			}
+			// A child that already has the column keeps its own
+			if !sameColumnType(col, cmd.Def.TypeName, cmd.Def.IsArray) {
+				return typeConflict(col.Name, false)
+			}
+			return nil
+		}
+		typ, err := c.columnType(table, cmd.Def)
+		if err != nil {
+			return err
+		}
+		col := &Column{
+			Name:      cmd.Def.Colname,
+			Type:      typ,
+			IsNotNull: cmd.Def.IsNotNull || c.isNotNullDomain(cmd.Def.TypeName),
+			IsArray:   cmd.Def.IsArray,
+			Length:    cmd.Def.Length,
+			Default:   cmd.Def.Default,
+			Identity:  cmd.Def.Identity,
+			Generated: cmd.Def.Generated,
+			Inherited: inherited,
+		}
+		table.Columns = append(table.Columns, col)
+		if !inherited {
+			schema.createColumnSequence(table, col)
+		}
 
-			case ast.AT_AlterColumnType:
-				table.Columns[idx].Type = *cmd.Def.TypeName
-				table.Columns[idx].IsArray = cmd.Def.IsArray
+	case ast.AT_AlterColumnType:
+		typ, err := c.columnType(table, cmd.Def)
+		if err != nil {
+			return err
+		}
+		table.Columns[idx].Type = typ
+		table.Columns[idx].IsArray = cmd.Def.IsArray
+		table.Columns[idx].Length = cmd.Def.Length
 
-			case ast.AT_DropColumn:
-				table.Columns = append(table.Columns[:idx], table.Columns[idx+1:]...)
+	case ast.AT_DropColumn:
+		col := table.Columns[idx]
+		// Columns that a child declared itself, or inherits from another
+		// parent, are kept
+		if inherited && (!col.Inherited || c.parentHasColumn(table, col.Name)) {
+			return nil
+		}
+		c.dropColumnConstraints(table, col.Name)
+		c.dropOwnedSequences(table, col.Name)
+		table.Columns = append(table.Columns[:idx], table.Columns[idx+1:]...)
 
-			case ast.AT_DropNotNull:
-				table.Columns[idx].IsNotNull = false
+	case ast.AT_DropNotNull:
+		table.Columns[idx].IsNotNull = false
 
-			case ast.AT_SetNotNull:
-				table.Columns[idx].IsNotNull = true
+	case ast.AT_SetNotNull:
+		table.Columns[idx].IsNotNull = true
+
+	case ast.AT_ColumnDefault:
+		table.Columns[idx].Default = cmd.Def.Default
+
+	case ast.AT_AddIdentity:
+		col := table.Columns[idx]
+		if col.Identity != 0 {
+			return &sqlerr.Error{
+				Code:    "55000",
+				Message: fmt.Sprintf("column \"%s\" of relation \"%s\" is already an identity column", col.Name, table.Rel.Name),
+			}
+		}
+		if !col.IsNotNull {
+			return &sqlerr.Error{
+				Code:    "55000",
+				Message: fmt.Sprintf("column \"%s\" of relation \"%s\" must be declared NOT NULL before identity can be added", col.Name, table.Rel.Name),
+			}
+		}
+		col.Identity = cmd.Def.Identity
+		schema.createColumnSequence(table, col)
 
+	case ast.AT_DropIdentity:
+		col := table.Columns[idx]
+		if col.Identity == 0 {
+			if cmd.MissingOk {
+				return nil
+			}
+			return &sqlerr.Error{
+				Code:    "55000",
+				Message: fmt.Sprintf("column \"%s\" of relation \"%s\" is not an identity column", col.Name, table.Rel.Name),
+			}
+		}
+		col.Identity = 0
+		c.dropOwnedSequences(table, col.Name)
+
+	case ast.AT_DropExpression:
+		col := table.Columns[idx]
+		if col.Generated == 0 {
+			if cmd.MissingOk {
+				return nil
+			}
+			return &sqlerr.Error{
+				Code:    "55000",
+				Message: fmt.Sprintf("column \"%s\" of relation \"%s\" is not a stored generated column", col.Name, table.Rel.Name),
+			}
+		}
+		col.Generated = 0
+
+	case ast.AT_RenameColumn:
+		return c.renameTableColumn(table, *cmd.Name, cmd.Def.Colname)
+
+	case ast.AT_AddConstraint:
+		if err := c.addConstraint(table, cmd.Constraint); err != nil {
+			return err
+		}
+
+	case ast.AT_DropConstraint:
+		if err := c.dropConstraint(table, *cmd.Name, cmd.MissingOk); err != nil {
+			return err
+		}
+
+	case ast.AT_AddIndex:
+		if err := c.createIndex(cmd.Index); err != nil {
+			return err
+		}
+
+	case ast.AT_DropIndex:
+		err := c.dropIndex(&ast.DropIndexStmt{
+			IfExists: cmd.MissingOk,
+			Indexes:  []*ast.TableName{{Name: *cmd.Name}},
+			Table:    table.Rel,
+		})
+		if err != nil {
+			return err
+		}
+
+	case ast.AT_AttachPartition:
+		return c.attachPartition(table, cmd)
+
+	case ast.AT_DetachPartition:
+		return c.detachPartition(table, cmd)
+
+	case ast.AT_AddInherit:
+		return c.addInherit(table, cmd.Relation)
+
+	case ast.AT_DropInherit:
+		return c.dropInherit(table, cmd.Relation)
+
+	}
+
+	switch cmd.Subtype {
+	case ast.AT_AddColumn,
+		ast.AT_AlterColumnType,
+		ast.AT_DropColumn,
+		ast.AT_DropNotNull,
+		ast.AT_SetNotNull,
+		ast.AT_ColumnDefault,
+		ast.AT_DropExpression:
+		for _, child := range c.children(table) {
+			childSchema, err := c.schemaOf(child)
+			if err != nil {
+				return err
+			}
+			if err := c.alterTableCmd(childSchema, child, cmd, true); err != nil {
+				return err
 			}
 		}
 	}
@@ -97,16 +271,27 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 	return nil
 }
 
-func (c *Catalog) alterTableSetSchema(stmt *ast.AlterTableSetSchemaStmt) error {
-	ns := stmt.Table.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
+// columnType returns the type of a column definition. MySQL's ENUM columns get
+// an enum type of their own, named after the table and column.
+func (c *Catalog) columnType(tbl *Table, def *ast.ColumnDef) (ast.TypeName, error) {
+	if def.Vals == nil {
+		return *c.QualifyType(def.TypeName), nil
 	}
-	oldSchema, err := c.getSchema(ns)
-	if err != nil {
-		return err
+	typeName := ast.TypeName{Name: fmt.Sprintf("%s_%s", tbl.Rel.Name, def.Colname)}
+	if _, typ, _, err := c.getType(&typeName); err == nil {
+		if enum, ok := typ.(*Enum); ok {
+			enum.Vals = stringSlice(def.Vals)
+			return typeName, nil
+		}
+	}
+	if err := c.createEnum(&ast.CreateEnumStmt{TypeName: &typeName, Vals: def.Vals}); err != nil {
+		return ast.TypeName{}, err
 	}
-	tbl, idx, err := oldSchema.getTable(stmt.Table)
+	return typeName, nil
+}
+
+func (c *Catalog) alterTableSetSchema(stmt *ast.AlterTableSetSchemaStmt) error {
+	oldSchema, tbl, err := c.getTable(stmt.Table)
 	if err != nil {
 		return err
 	}
@@ -117,17 +302,40 @@ func (c *Catalog) alterTableSetSchema(stmt *ast.AlterTableSetSchemaStmt) error {
 	if _, _, err := newSchema.getTable(stmt.Table); err == nil {
 		return sqlerr.RelationExists(stmt.Table.Name)
 	}
+	for _, fk := range c.foreignKeysTo(tbl) {
+		fk.RefTable.Schema = newSchema.Name
+	}
+	// Indexes move along with their table
+	var indexes []*Index
+	for _, index := range oldSchema.Indexes {
+		if sameTable(index.Table, tbl.Rel) {
+			newSchema.Indexes = append(newSchema.Indexes, index)
+		} else {
+			indexes = append(indexes, index)
+		}
+	}
+	oldSchema.Indexes = indexes
+	// So do the sequences it owns
+	var sequences []*Sequence
+	for _, seq := range oldSchema.Sequences {
+		if sameTable(seq.OwnedBy, tbl.Rel) {
+			newSchema.Sequences = append(newSchema.Sequences, seq)
+		} else {
+			sequences = append(sequences, seq)
+		}
+	}
+	oldSchema.Sequences = sequences
+	_, idx, _ := oldSchema.getTable(tbl.Rel)
 	oldSchema.Tables = append(oldSchema.Tables[:idx], oldSchema.Tables[idx+1:]...)
 	newSchema.Tables = append(newSchema.Tables, tbl)
+	if tbl.Rel.Schema != "" || newSchema.Name != c.DefaultSchema {
+		tbl.Rel.Schema = newSchema.Name
+	}
 	return nil
 }
 
 func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
-	ns := stmt.Name.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	schema, err := c.getSchema(ns)
+	schema, err := c.creationSchema(stmt.Name.Schema)
 	if err != nil {
 		return err
 	}
@@ -138,7 +346,11 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		return sqlerr.RelationExists(stmt.Name.Name)
 	}
 
-	tbl := Table{Rel: stmt.Name, Comment: stmt.Comment}
+	tbl := Table{
+		Rel:          c.qualify(stmt.Name, schema),
+		Comment:      stmt.Comment,
+		PartitionKey: stmt.PartitionSpec,
+	}
 
 	if stmt.ReferTable != nil && len(stmt.Cols) != 0 {
 		return errors.New("create table node cannot have both a ReferTable and Cols")
@@ -154,53 +366,85 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 			tbl.Columns = append(tbl.Columns, &newCol)
 		}
 	} else {
+		if err := c.inheritColumns(&tbl, stmt); err != nil {
+			return err
+		}
 		for _, col := range stmt.Cols {
+			if inherited := tbl.column(col.Colname); inherited != nil {
+				if err := mergeColumn(inherited, col); err != nil {
+					return err
+				}
+				continue
+			}
+			if col.TypeName == nil {
+				return sqlerr.ColumnNotFound(stmt.Name.Name, col.Colname)
+			}
+			typ, err := c.columnType(&tbl, col)
+			if err != nil {
+				return err
+			}
 			tc := &Column{
 				Name:      col.Colname,
-				Type:      *col.TypeName,
-				IsNotNull: col.IsNotNull,
+				Type:      typ,
+				IsNotNull: col.IsNotNull || c.isNotNullDomain(col.TypeName),
 				IsArray:   col.IsArray,
 				Comment:   col.Comment,
 				Length:    col.Length,
-			}
-			if col.Vals != nil {
-				typeName := ast.TypeName{
-					Name: fmt.Sprintf("%s_%s", stmt.Name.Name, col.Colname),
-				}
-				s := &ast.CreateEnumStmt{TypeName: &typeName, Vals: col.Vals}
-				if err := c.createEnum(s); err != nil {
-					return err
-				}
-				tc.Type = typeName
+				Default:   col.Default,
+				Identity:  col.Identity,
+				Generated: col.Generated,
 			}
 			tbl.Columns = append(tbl.Columns, tc)
 		}
 	}
+	for _, con := range stmt.Constraints {
+		if err := c.addConstraint(&tbl, con); err != nil {
+			return err
+		}
+	}
 	schema.Tables = append(schema.Tables, &tbl)
+	if stmt.ReferTable == nil {
+		for _, col := range tbl.Columns {
+			if !col.Inherited {
+				schema.createColumnSequence(&tbl, col)
+			}
+		}
+	}
+	for _, idx := range stmt.Indexes {
+		if err := c.createIndex(idx); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (c *Catalog) dropTable(stmt *ast.DropTableStmt) error {
 	for _, name := range stmt.Tables {
-		ns := name.Schema
-		if ns == "" {
-			ns = c.DefaultSchema
-		}
-		schema, err := c.getSchema(ns)
+		schema, tbl, err := c.getTable(name)
 		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
 			continue
 		} else if err != nil {
 			return err
 		}
 
-		_, idx, err := schema.getTable(name)
-		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
-			continue
-		} else if err != nil {
+		if err := c.dropTableFrom(schema, tbl); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		schema.Tables = append(schema.Tables[:idx], schema.Tables[idx+1:]...)
+func (c *Catalog) dropTableFrom(schema *Schema, tbl *Table) error {
+	if err := c.dropChildren(tbl); err != nil {
+		return err
+	}
+	schema.dropTableIndexes(tbl)
+	c.dropOwnedSequences(tbl, "")
+	for i, t := range schema.Tables {
+		if t == tbl {
+			schema.Tables = append(schema.Tables[:i], schema.Tables[i+1:]...)
+			break
+		}
 	}
 	return nil
 }
@@ -210,19 +454,33 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 	if err != nil {
 		return err
 	}
+	return c.renameTableColumn(tbl, stmt.Col.Name, *stmt.NewName)
+}
+
+// renameTableColumn renames a column, along with the columns that inherit it
+func (c *Catalog) renameTableColumn(tbl *Table, old, new string) error {
 	idx := -1
 	for i := range tbl.Columns {
-		if tbl.Columns[i].Name == stmt.Col.Name {
+		if tbl.Columns[i].Name == old {
 			idx = i
 		}
-		if tbl.Columns[i].Name == *stmt.NewName {
-			return sqlerr.ColumnExists(tbl.Rel.Name, *stmt.NewName)
+		if tbl.Columns[i].Name == new {
+			return sqlerr.ColumnExists(tbl.Rel.Name, new)
 		}
 	}
 	if idx == -1 {
-		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
+		return sqlerr.ColumnNotFound(tbl.Rel.Name, old)
+	}
+	c.renameColumnReferences(tbl, old, new)
+	tbl.Columns[idx].Name = new
+	for _, child := range c.children(tbl) {
+		if child.column(old) == nil {
+			continue
+		}
+		if err := c.renameTableColumn(child, old, new); err != nil {
+			return err
+		}
 	}
-	tbl.Columns[idx].Name = *stmt.NewName
 	return nil
 }
 
@@ -235,6 +493,9 @@ func (c *Catalog) renameTable(stmt *ast.RenameTableStmt) error {
 		return sqlerr.RelationExists(*stmt.NewName)
 	}
 	if stmt.NewName != nil {
+		for _, fk := range c.foreignKeysTo(tbl) {
+			fk.RefTable.Name = *stmt.NewName
+		}
 		tbl.Rel.Name = *stmt.NewName
 	}
 	return nil
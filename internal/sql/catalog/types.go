@@ -9,11 +9,7 @@ import (
 )
 
 func (c *Catalog) createEnum(stmt *ast.CreateEnumStmt) error {
-	ns := stmt.TypeName.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	schema, err := c.getSchema(ns)
+	schema, err := c.creationSchema(stmt.TypeName.Schema)
 	if err != nil {
 		return err
 	}
@@ -38,11 +34,7 @@ func (c *Catalog) createEnum(stmt *ast.CreateEnumStmt) error {
 }
 
 func (c *Catalog) createCompositeType(stmt *ast.CompositeTypeStmt) error {
-	ns := stmt.TypeName.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	schema, err := c.getSchema(ns)
+	schema, err := c.creationSchema(stmt.TypeName.Schema)
 	if err != nil {
 		return err
 	}
@@ -59,22 +51,25 @@ func (c *Catalog) createCompositeType(stmt *ast.CompositeTypeStmt) error {
 	if _, _, err := schema.getType(stmt.TypeName); err == nil {
 		return sqlerr.TypeExists(tbl.Name)
 	}
-	schema.Types = append(schema.Types, &CompositeType{
+	ct := &CompositeType{
 		Name: stmt.TypeName.Name,
-	})
+	}
+	for _, col := range stmt.Cols {
+		if ct.column(col.Colname) != nil {
+			return sqlerr.ColumnExists(ct.Name, col.Colname)
+		}
+		ct.Columns = append(ct.Columns, &Column{
+			Name:    col.Colname,
+			Type:    *c.QualifyType(col.TypeName),
+			IsArray: col.IsArray,
+		})
+	}
+	schema.Types = append(schema.Types, ct)
 	return nil
 }
 
 func (c *Catalog) alterTypeRenameValue(stmt *ast.AlterTypeRenameValueStmt) error {
-	ns := stmt.Type.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	schema, err := c.getSchema(ns)
-	if err != nil {
-		return err
-	}
-	typ, _, err := schema.getType(stmt.Type)
+	_, typ, _, err := c.getType(stmt.Type)
 	if err != nil {
 		return err
 	}
@@ -104,15 +99,7 @@ func (c *Catalog) alterTypeRenameValue(stmt *ast.AlterTypeRenameValueStmt) error
 }
 
 func (c *Catalog) alterTypeAddValue(stmt *ast.AlterTypeAddValueStmt) error {
-	ns := stmt.Type.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	schema, err := c.getSchema(ns)
-	if err != nil {
-		return err
-	}
-	typ, _, err := schema.getType(stmt.Type)
+	_, typ, _, err := c.getType(stmt.Type)
 	if err != nil {
 		return err
 	}
@@ -140,18 +127,7 @@ func (c *Catalog) alterTypeAddValue(stmt *ast.AlterTypeAddValueStmt) error {
 
 func (c *Catalog) dropType(stmt *ast.DropTypeStmt) error {
 	for _, name := range stmt.Types {
-		ns := name.Schema
-		if ns == "" {
-			ns = c.DefaultSchema
-		}
-		schema, err := c.getSchema(ns)
-		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
-			continue
-		} else if err != nil {
-			return err
-		}
-
-		_, idx, err := schema.getType(name)
+		schema, _, idx, err := c.getType(name)
 		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
 			continue
 		} else if err != nil {
@@ -168,15 +144,7 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 		return fmt.Errorf("rename type: empty name")
 	}
 	newName := *stmt.NewName
-	ns := stmt.Type.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	schema, err := c.getSchema(ns)
-	if err != nil {
-		return err
-	}
-	ityp, idx, err := schema.getType(stmt.Type)
+	schema, ityp, idx, err := c.getType(stmt.Type)
 	if err != nil {
 		return err
 	}
@@ -192,6 +160,7 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 	case *CompositeType:
 		schema.Types[idx] = &CompositeType{
 			Name:    newName,
+			Columns: typ.Columns,
 			Comment: typ.Comment,
 		}
 
@@ -202,13 +171,33 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 			Comment: typ.Comment,
 		}
 
+	case *Domain:
+		domain := *typ
+		domain.Name = newName
+		schema.Types[idx] = &domain
+
 	default:
 		return fmt.Errorf("unsupported type: %T", typ)
 
 	}
 
-	// Update all the table columns with the new type
+	// Update all the table columns, composite type attributes and domains
+	// with the new type
 	for _, schema := range c.Schemas {
+		for _, typ := range schema.Types {
+			switch typ := typ.(type) {
+			case *Domain:
+				if typ.BaseType == *stmt.Type {
+					typ.BaseType.Name = newName
+				}
+			case *CompositeType:
+				for _, column := range typ.Columns {
+					if column.Type == *stmt.Type {
+						column.Type.Name = newName
+					}
+				}
+			}
+		}
 		for _, table := range schema.Tables {
 			for _, column := range table.Columns {
 				if column.Type == *stmt.Type {
@@ -0,0 +1,80 @@
+package catalog
+
+import (
+	"errors"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// The catalog can't compute the output columns of a query on its own, so the
+// compiler passes in a column generator when updating the catalog. Views and
+// tables created from a query have no columns without one.
+type columnGenerator interface {
+	OutputColumns(node ast.Node) ([]*Column, error)
+}
+
+func (c *Catalog) createView(stmt *ast.ViewStmt, colGen columnGenerator) error {
+	return c.createRelationFromQuery(stmt.View, stmt.Aliases, stmt.Query, stmt.Replace, false, colGen)
+}
+
+func (c *Catalog) createTableAs(stmt *ast.CreateTableAsStmt, colGen columnGenerator) error {
+	if stmt.Into == nil {
+		return errors.New("create table as: missing into clause")
+	}
+	return c.createRelationFromQuery(stmt.Into.Rel, stmt.Into.ColNames, stmt.Query, false, stmt.IfNotExists, colGen)
+}
+
+func (c *Catalog) createRelationFromQuery(rv *ast.RangeVar, aliases *ast.List, query ast.Node, replace, ifNotExists bool, colGen columnGenerator) error {
+	if rv == nil || rv.Relname == nil {
+		return errors.New("create view: empty name")
+	}
+	rel := &ast.TableName{Name: *rv.Relname}
+	if rv.Catalogname != nil {
+		rel.Catalog = *rv.Catalogname
+	}
+	if rv.Schemaname != nil {
+		rel.Schema = *rv.Schemaname
+	}
+	schema, err := c.creationSchema(rel.Schema)
+	if err != nil {
+		return err
+	}
+	rel = c.qualify(rel, schema)
+	_, idx, err := schema.getTable(rel)
+	if err == nil && ifNotExists {
+		return nil
+	} else if err == nil && !replace {
+		return sqlerr.RelationExists(rel.Name)
+	}
+
+	// Without a column generator, such as when the catalog is built on its
+	// own, the relation is registered without any columns
+	var cols []*Column
+	if colGen != nil {
+		cols, err = colGen.OutputColumns(query)
+		if err != nil {
+			return err
+		}
+	}
+	if aliases != nil && colGen != nil {
+		names := stringSlice(aliases)
+		if len(names) > len(cols) {
+			return &sqlerr.Error{
+				Code:    "42601",
+				Message: "CREATE VIEW specifies more column names than columns",
+			}
+		}
+		for i, name := range names {
+			cols[i].Name = name
+		}
+	}
+
+	tbl := &Table{Rel: rel, Columns: cols}
+	if idx >= 0 {
+		schema.Tables[idx] = tbl
+	} else {
+		schema.Tables = append(schema.Tables, tbl)
+	}
+	return nil
+}
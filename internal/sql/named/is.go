@@ -13,10 +13,30 @@ func IsParamFunc(node ast.Node) bool {
 	if call.Func == nil {
 		return false
 	}
-	return call.Func.Schema == "sqlc" && call.Func.Name == "arg"
+	if call.Func.Schema != "sqlc" {
+		return false
+	}
+	switch call.Func.Name {
+	case "arg", "narg", "slice":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsNullableParamFunc reports whether the node is a sqlc.narg call, whose
+// parameter is always nullable
+func IsNullableParamFunc(node ast.Node) bool {
+	return IsParamFunc(node) && node.(*ast.FuncCall).Func.Name == "narg"
 }
 
 func IsParamSign(node ast.Node) bool {
 	expr, ok := node.(*ast.A_Expr)
 	return ok && astutils.Join(expr.Name, ".") == "@"
 }
+
+// IsSqlcSliceFunc reports whether the node is a sqlc.slice call, whose
+// parameter is expanded to a list of placeholders at runtime
+func IsSqlcSliceFunc(node ast.Node) bool {
+	return IsParamFunc(node) && node.(*ast.FuncCall).Func.Name == "slice"
+}
@@ -0,0 +1,19 @@
+package named
+
+import "fmt"
+
+// Param is a named query parameter
+type Param struct {
+	Name string
+	// Parameters declared with sqlc.narg are nullable, whatever column they
+	// are compared with
+	Nullable bool
+	// Parameters declared with sqlc.slice take a list of values
+	IsSqlcSlice bool
+}
+
+// SlicePlaceholder returns the comment-tagged placeholder that marks where the
+// values of a sqlc.slice parameter are inserted at runtime
+func SlicePlaceholder(name string) string {
+	return fmt.Sprintf("/*SLICE:%s*/?", name)
+}
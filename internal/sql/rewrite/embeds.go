@@ -0,0 +1,85 @@
+package rewrite
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+)
+
+// Embed is an instance of `sqlc.embed(param)`
+type Embed struct {
+	Table *ast.TableName
+	param string
+	Node  *ast.ColumnRef
+}
+
+// Orig is the text of the original sqlc.embed call, which is replaced when
+// the star reference is expanded
+func (e Embed) Orig() string {
+	return fmt.Sprintf("sqlc.embed(%s)", e.param)
+}
+
+type EmbedSet []*Embed
+
+// Find returns the embed for a star reference created by Embeds
+func (es EmbedSet) Find(node *ast.ColumnRef) (*Embed, bool) {
+	for _, e := range es {
+		if e.Node == node {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+func isEmbed(node ast.Node) bool {
+	call, ok := node.(*ast.FuncCall)
+	if !ok || call.Func == nil {
+		return false
+	}
+	return call.Func.Schema == "sqlc" && call.Func.Name == "embed"
+}
+
+// Embeds replaces each sqlc.embed(table) in a target list with a table.* star
+// reference. The returned set is used to tell those references apart from
+// star references written by hand.
+func Embeds(raw *ast.RawStmt) (*ast.RawStmt, EmbedSet) {
+	var embeds EmbedSet
+	node := astutils.Apply(raw, func(cr *astutils.Cursor) bool {
+		node := cr.Node()
+		if !isEmbed(node) {
+			return true
+		}
+		if _, ok := cr.Parent().(*ast.ResTarget); !ok {
+			return false
+		}
+		fun := node.(*ast.FuncCall)
+		if fun.Args == nil || len(fun.Args.Items) != 1 {
+			return false
+		}
+		param, _ := flatten(fun.Args)
+		ref := &ast.ColumnRef{
+			Fields: &ast.List{
+				Items: []ast.Node{
+					&ast.String{Str: param},
+					&ast.A_Star{},
+				},
+			},
+			Location: fun.Location,
+		}
+		embeds = append(embeds, &Embed{
+			Table: &ast.TableName{Name: param},
+			param: param,
+			Node:  ref,
+		})
+		cr.Replace(ref)
+		return false
+	}, nil)
+	return node.(*ast.RawStmt), embeds
+}
+
+// IsEmbedUnresolved reports whether a sqlc.embed call is left in the tree
+// after Embeds has run, i.e. one used outside of a target list
+func IsEmbedUnresolved(root ast.Node) bool {
+	return len(astutils.Search(root, isEmbed).Items) > 0
+}
@@ -2,6 +2,8 @@ package rewrite
 
 import (
 	"fmt"
+	"sort"
+	"unicode"
 
 	"github.com/kyleconroy/sqlc/internal/config"
 	"github.com/kyleconroy/sqlc/internal/source"
@@ -41,17 +43,70 @@ func isNamedParamSignCast(node ast.Node) bool {
 	return astutils.Join(expr.Name, ".") == "@" && cast
 }
 
-func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[int]string, []source.Edit) {
-	foundFunc := astutils.Search(raw, named.IsParamFunc)
-	foundSign := astutils.Search(raw, named.IsParamSign)
-	if len(foundFunc.Items)+len(foundSign.Items) == 0 {
-		return raw, map[int]string{}, nil
+func isNamedParam(node ast.Node) bool {
+	return named.IsParamFunc(node) || named.IsParamSign(node)
+}
+
+// paramName returns the name of a sqlc.arg call or @name operator, and where
+// it starts in the query
+func paramName(node ast.Node) (string, int) {
+	switch n := node.(type) {
+	case *ast.FuncCall:
+		name, _ := flatten(n.Args)
+		return name, n.Location
+	case *ast.A_Expr:
+		arg := n.Rexpr
+		if cast, ok := arg.(*ast.TypeCast); ok {
+			arg = cast.Arg
+		}
+		name, _ := flatten(arg)
+		return name, n.Location
 	}
+	return "", 0
+}
 
-	hasNamedParameterSupport := engine != config.EngineMySQL
+// NamedParameters replaces the sqlc.arg calls and @name operators of a
+// statement with numbered parameters. Parameters are numbered in the order
+// that they appear in the query, and a name that's repeated keeps its number.
+// The returned edits rewrite the source of the
+// statement, which is given by sql.
+func NamedParameters(engine config.Engine, raw *ast.RawStmt, sql string) (*ast.RawStmt, map[int]named.Param, []source.Edit) {
+	found := astutils.Search(raw, isNamedParam)
+	if len(found.Items) == 0 {
+		return raw, map[int]named.Param{}, nil
+	}
 
+	// The AST isn't walked in the order of the query, so number the
+	// parameters by location first
+	sort.SliceStable(found.Items, func(i, j int) bool {
+		_, li := paramName(found.Items[i])
+		_, lj := paramName(found.Items[j])
+		return li < lj
+	})
 	args := map[string]int{}
+	numbers := map[int]int{}
+	names := map[int]string{}
+	nullable := map[string]bool{}
+	slices := map[string]bool{}
 	argn := 0
+	for _, node := range found.Items {
+		param, loc := paramName(node)
+		if named.IsNullableParamFunc(node) {
+			nullable[param] = true
+		}
+		if named.IsSqlcSliceFunc(node) {
+			slices[param] = true
+		}
+		num, ok := args[param]
+		if !ok {
+			argn += 1
+			num = argn
+			args[param] = num
+		}
+		numbers[loc] = num
+		names[num] = param
+	}
+
 	var edits []source.Edit
 	node := astutils.Apply(raw, func(cr *astutils.Cursor) bool {
 		node := cr.Node()
@@ -59,87 +114,46 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 
 		case named.IsParamFunc(node):
 			fun := node.(*ast.FuncCall)
-			param, isConst := flatten(fun.Args)
-			if num, ok := args[param]; ok && hasNamedParameterSupport {
-				cr.Replace(&ast.ParamRef{
-					Number:   num,
-					Location: fun.Location,
-				})
-			} else {
-				argn += 1
-				args[param] = argn
-				cr.Replace(&ast.ParamRef{
-					Number:   argn,
-					Location: fun.Location,
-				})
-			}
-			// TODO: This code assumes that sqlc.arg(name) is on a single line
-			var old, replace string
-			if isConst {
-				old = fmt.Sprintf("sqlc.arg('%s')", param)
-			} else {
-				old = fmt.Sprintf("sqlc.arg(%s)", param)
-			}
-			if engine == config.EngineMySQL {
+			param, loc := paramName(fun)
+			num := numbers[loc]
+			cr.Replace(&ast.ParamRef{
+				Number:   num,
+				Location: loc,
+			})
+			var replace string
+			if named.IsSqlcSliceFunc(fun) {
+				replace = named.SlicePlaceholder(param)
+			} else if engine == config.EngineMySQL {
 				replace = "?"
 			} else {
-				replace = fmt.Sprintf("$%d", args[param])
+				replace = fmt.Sprintf("$%d", num)
 			}
 			edits = append(edits, source.Edit{
-				Location: fun.Location - raw.StmtLocation,
-				Old:      old,
+				Location: loc - raw.StmtLocation,
+				Old:      callText(sql, loc-raw.StmtLocation),
 				New:      replace,
 			})
 			return false
 
-		case isNamedParamSignCast(node):
-			expr := node.(*ast.A_Expr)
-			cast := expr.Rexpr.(*ast.TypeCast)
-			param, _ := flatten(cast.Arg)
-			if num, ok := args[param]; ok {
-				cast.Arg = &ast.ParamRef{
-					Number:   num,
-					Location: expr.Location,
-				}
-				cr.Replace(cast)
-			} else {
-				argn += 1
-				args[param] = argn
-				cast.Arg = &ast.ParamRef{
-					Number:   argn,
-					Location: expr.Location,
-				}
-				cr.Replace(cast)
-			}
-			// TODO: This code assumes that @foo::bool is on a single line
-			edits = append(edits, source.Edit{
-				Location: expr.Location - raw.StmtLocation,
-				Old:      fmt.Sprintf("@%s", param),
-				New:      fmt.Sprintf("$%d", args[param]),
-			})
-			return false
-
 		case named.IsParamSign(node):
 			expr := node.(*ast.A_Expr)
-			param, _ := flatten(expr.Rexpr)
-			if num, ok := args[param]; ok {
-				cr.Replace(&ast.ParamRef{
-					Number:   num,
-					Location: expr.Location,
-				})
+			_, loc := paramName(expr)
+			ref := &ast.ParamRef{
+				Number:   numbers[loc],
+				Location: loc,
+			}
+			if isNamedParamSignCast(expr) {
+				// @foo::bool is parsed as @(foo::bool)
+				cast := expr.Rexpr.(*ast.TypeCast)
+				cast.Arg = ref
+				cr.Replace(cast)
 			} else {
-				argn += 1
-				args[param] = argn
-				cr.Replace(&ast.ParamRef{
-					Number:   argn,
-					Location: expr.Location,
-				})
+				cr.Replace(ref)
 			}
-			// TODO: This code assumes that @foo is on a single line
 			edits = append(edits, source.Edit{
-				Location: expr.Location - raw.StmtLocation,
-				Old:      fmt.Sprintf("@%s", param),
-				New:      fmt.Sprintf("$%d", args[param]),
+				Location: loc - raw.StmtLocation,
+				Old:      signText(sql, loc-raw.StmtLocation),
+				New:      fmt.Sprintf("$%d", ref.Number),
 			})
 			return false
 
@@ -148,9 +162,67 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 		}
 	}, nil)
 
-	named := map[int]string{}
-	for k, v := range args {
-		named[v] = k
+	params := map[int]named.Param{}
+	for num, name := range names {
+		params[num] = named.Param{Name: name, Nullable: nullable[name], IsSqlcSlice: slices[name]}
 	}
-	return node.(*ast.RawStmt), named, edits
+	return node.(*ast.RawStmt), params, edits
+}
+
+// callText returns the source of the function call that starts at loc, up to
+// and including its closing parenthesis. The call may span several lines.
+func callText(sql string, loc int) string {
+	depth := 0
+	for i := loc; i < len(sql); i++ {
+		switch sql[i] {
+		case '\'', '"':
+			i = skipQuoted(sql, i)
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return sql[loc : i+1]
+			}
+		}
+	}
+	return sql[loc:]
+}
+
+// signText returns the source of the @name operator that starts at loc. The
+// name may be quoted and may be separated from the @ by whitespace.
+func signText(sql string, loc int) string {
+	i := loc + 1
+	for i < len(sql) && unicode.IsSpace(rune(sql[i])) {
+		i++
+	}
+	if i < len(sql) && sql[i] == '"' {
+		return sql[loc : skipQuoted(sql, i)+1]
+	}
+	for i < len(sql) && isIdentChar(sql[i]) {
+		i++
+	}
+	return sql[loc:i]
+}
+
+// skipQuoted returns the position of the quote that closes the string or
+// identifier opened at i. Doubled quotes are part of the contents.
+func skipQuoted(sql string, i int) int {
+	quote := sql[i]
+	for i++; i < len(sql); i++ {
+		if sql[i] != quote {
+			continue
+		}
+		if i+1 < len(sql) && sql[i+1] == quote {
+			i++
+			continue
+		}
+		return i
+	}
+	return len(sql) - 1
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
 }
@@ -47,6 +47,30 @@ func ColumnNotFound(rel, col string) *Error {
 	}
 }
 
+func ConstraintExists(rel, name string) *Error {
+	return &Error{
+		Err:     Exists,
+		Code:    "42710",
+		Message: fmt.Sprintf("constraint \"%s\" for relation \"%s\"", name, rel),
+	}
+}
+
+func ConstraintNotFound(rel, name string) *Error {
+	return &Error{
+		Err:     NotFound,
+		Code:    "42704",
+		Message: fmt.Sprintf("constraint \"%s\" of relation \"%s\"", name, rel),
+	}
+}
+
+func IndexNotFound(name string) *Error {
+	return &Error{
+		Err:     NotFound,
+		Code:    "42704",
+		Message: fmt.Sprintf("index \"%s\"", name),
+	}
+}
+
 func RelationExists(rel string) *Error {
 	return &Error{
 		Err:     Exists,
@@ -79,6 +103,14 @@ func SchemaNotFound(sch string) *Error {
 	}
 }
 
+func SequenceNotFound(name string) *Error {
+	return &Error{
+		Err:     NotFound,
+		Code:    "42P01",
+		Message: fmt.Sprintf("sequence \"%s\"", name),
+	}
+}
+
 func TypeExists(typ string) *Error {
 	return &Error{
 		Err:     Exists,
@@ -0,0 +1,30 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// CallStmt checks that a CALL statement invokes a procedure. Unlike other
+// function calls, a CALL to an unknown procedure is an error.
+func CallStmt(c *catalog.Catalog, n ast.Node) error {
+	stmt, ok := n.(*ast.CallStmt)
+	if !ok || stmt.FuncCall == nil {
+		return nil
+	}
+	fun, err := c.ResolveFuncCall(stmt.FuncCall)
+	if err != nil {
+		return err
+	}
+	if !fun.IsProcedure {
+		return &sqlerr.Error{
+			Code:     "42809",
+			Message:  fmt.Sprintf("%s is not a procedure", stmt.FuncCall.Func.Name),
+			Location: stmt.FuncCall.Location,
+		}
+	}
+	return nil
+}
@@ -3,12 +3,15 @@ package validate
 import (
 	"fmt"
 
+	"github.com/kyleconroy/sqlc/internal/metadata"
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 )
 
 func Cmd(n ast.Node, name, cmd string) error {
 	// TODO: Convert cmd to an enum
-	if !(cmd == ":many" || cmd == ":one") {
+	switch cmd {
+	case metadata.CmdMany, metadata.CmdOne, metadata.CmdBatchMany, metadata.CmdBatchOne:
+	default:
 		return nil
 	}
 	var list *ast.List
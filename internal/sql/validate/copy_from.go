@@ -0,0 +1,45 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+)
+
+// CopyFrom checks that a :copyfrom query is a single-table INSERT with an
+// explicit column list and one VALUES row made up of distinct parameters.
+func CopyFrom(n ast.Node, name string) error {
+	stmt, ok := n.(*ast.InsertStmt)
+	if !ok {
+		return fmt.Errorf("query %q uses :copyfrom, which only supports INSERT statements", name)
+	}
+	if stmt.WithClause != nil || stmt.OnConflictClause != nil {
+		return fmt.Errorf("query %q uses :copyfrom, which does not support WITH or ON CONFLICT clauses", name)
+	}
+	if stmt.ReturningList != nil && len(stmt.ReturningList.Items) > 0 {
+		return fmt.Errorf("query %q uses :copyfrom, which does not support RETURNING", name)
+	}
+	if stmt.Cols == nil || len(stmt.Cols.Items) == 0 {
+		return fmt.Errorf("query %q uses :copyfrom, which requires an explicit column list", name)
+	}
+	sel, ok := stmt.SelectStmt.(*ast.SelectStmt)
+	if !ok || sel.ValuesLists == nil || len(sel.ValuesLists.Items) != 1 {
+		return fmt.Errorf("query %q uses :copyfrom, which requires a single VALUES list", name)
+	}
+	values, ok := sel.ValuesLists.Items[0].(*ast.List)
+	if !ok {
+		return fmt.Errorf("query %q uses :copyfrom, which requires a single VALUES list", name)
+	}
+	seen := map[int]struct{}{}
+	for _, item := range values.Items {
+		ref, ok := item.(*ast.ParamRef)
+		if !ok {
+			return fmt.Errorf("query %q uses :copyfrom, which requires every value to be a parameter", name)
+		}
+		if _, dup := seen[ref.Number]; dup {
+			return fmt.Errorf("query %q uses :copyfrom, which requires every value to be a distinct parameter", name)
+		}
+		seen[ref.Number] = struct{}{}
+	}
+	return nil
+}
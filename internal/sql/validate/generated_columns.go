@@ -0,0 +1,151 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// GeneratedColumns checks that INSERT and UPDATE statements don't write to
+// generated columns, or to identity columns that are always generated. These
+// columns may only be set to DEFAULT.
+func GeneratedColumns(c *catalog.Catalog, n ast.Node) error {
+	switch stmt := n.(type) {
+	case *ast.InsertStmt:
+		return insertGeneratedColumns(c, stmt)
+	case *ast.UpdateStmt:
+		return updateGeneratedColumns(c, stmt)
+	}
+	return nil
+}
+
+func lookupTable(c *catalog.Catalog, rv *ast.RangeVar) (*catalog.Table, bool) {
+	if rv == nil || rv.Relname == nil {
+		return nil, false
+	}
+	name := &ast.TableName{Name: *rv.Relname}
+	if rv.Schemaname != nil {
+		name.Schema = *rv.Schemaname
+	}
+	table, err := c.GetTable(name)
+	if err != nil {
+		return nil, false
+	}
+	return &table, true
+}
+
+// isWritable reports whether a column accepts values other than DEFAULT. An
+// identity column that is always generated accepts them with OVERRIDING
+// SYSTEM VALUE, which uses them, or OVERRIDING USER VALUE, which ignores them.
+func isWritable(col *catalog.Column, override ast.OverridingKind) bool {
+	if col.Generated != 0 {
+		return false
+	}
+	return col.Identity != 'a' || override == ast.OverridingSystemValue || override == ast.OverridingUserValue
+}
+
+func findColumn(table *catalog.Table, name string) *catalog.Column {
+	for _, col := range table.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+func insertGeneratedColumns(c *catalog.Catalog, stmt *ast.InsertStmt) error {
+	table, ok := lookupTable(c, stmt.Relation)
+	if !ok {
+		return nil
+	}
+
+	// Without a column list, values are assigned to the columns in order,
+	// and errors point at the values instead
+	var cols []*catalog.Column
+	var locations []int
+	listed := stmt.Cols != nil && len(stmt.Cols.Items) > 0
+	if !listed {
+		cols = table.Columns
+		locations = make([]int, len(cols))
+		if sel, ok := stmt.SelectStmt.(*ast.SelectStmt); ok && sel.TargetList != nil {
+			for i, item := range sel.TargetList.Items {
+				if i < len(locations) {
+					locations[i] = item.Pos()
+				}
+			}
+		}
+	} else {
+		for _, item := range stmt.Cols.Items {
+			target, ok := item.(*ast.ResTarget)
+			if !ok || target.Name == nil {
+				return nil
+			}
+			cols = append(cols, findColumn(table, *target.Name))
+			locations = append(locations, target.Location)
+		}
+	}
+
+	var rows []*ast.List
+	if sel, ok := stmt.SelectStmt.(*ast.SelectStmt); ok && sel.ValuesLists != nil {
+		for _, item := range sel.ValuesLists.Items {
+			if row, ok := item.(*ast.List); ok {
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	for i, col := range cols {
+		if col == nil || isWritable(col, stmt.Override) {
+			continue
+		}
+		// INSERT ... SELECT always supplies a value
+		assigned := len(rows) == 0 && stmt.SelectStmt != nil
+		for _, row := range rows {
+			if i >= len(row.Items) {
+				continue
+			}
+			if _, ok := row.Items[i].(*ast.SetToDefault); !ok {
+				if !assigned && !listed {
+					locations[i] = row.Items[i].Pos()
+				}
+				assigned = true
+			}
+		}
+		if assigned {
+			return &sqlerr.Error{
+				Code:     "428C9",
+				Message:  fmt.Sprintf("cannot insert a non-DEFAULT value into column \"%s\"", col.Name),
+				Location: locations[i],
+			}
+		}
+	}
+	return nil
+}
+
+func updateGeneratedColumns(c *catalog.Catalog, stmt *ast.UpdateStmt) error {
+	table, ok := lookupTable(c, stmt.Relation)
+	if !ok || stmt.TargetList == nil {
+		return nil
+	}
+	for _, item := range stmt.TargetList.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok || target.Name == nil {
+			continue
+		}
+		col := findColumn(table, *target.Name)
+		if col == nil || isWritable(col, ast.OverridingNotSet) {
+			continue
+		}
+		if _, ok := target.Val.(*ast.SetToDefault); ok {
+			continue
+		}
+		return &sqlerr.Error{
+			Code:     "428C9",
+			Message:  fmt.Sprintf("column \"%s\" can only be updated to DEFAULT", col.Name),
+			Location: target.Location,
+		}
+	}
+	return nil
+}
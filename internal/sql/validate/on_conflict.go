@@ -0,0 +1,110 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// OnConflict checks the ON CONFLICT clause of an INSERT statement against the
+// table that's inserted into. The conflict target has to name columns or a
+// constraint of the table, and DO UPDATE can only set columns of the table and
+// read columns of the excluded row. MySQL's VALUES(col) is converted into a
+// reference to the excluded row, so the errors don't name the excluded row.
+func OnConflict(c *catalog.Catalog, stmt *ast.InsertStmt) error {
+	clause := stmt.OnConflictClause
+	if clause == nil {
+		return nil
+	}
+	table, ok := lookupTable(c, stmt.Relation)
+	if !ok {
+		return nil
+	}
+
+	if infer := clause.Infer; infer != nil {
+		if infer.Conname != nil && !hasConstraint(table, *infer.Conname) {
+			return &sqlerr.Error{
+				Code:     "42704",
+				Message:  fmt.Sprintf("constraint \"%s\" for table \"%s\" does not exist", *infer.Conname, table.Rel.Name),
+				Location: infer.Location,
+			}
+		}
+		if infer.IndexElems != nil {
+			for _, item := range infer.IndexElems.Items {
+				elem, ok := item.(*ast.IndexElem)
+				if !ok || elem.Name == nil {
+					continue
+				}
+				if findColumn(table, *elem.Name) == nil {
+					return &sqlerr.Error{
+						Code:     "42703",
+						Message:  fmt.Sprintf("column \"%s\" does not exist", *elem.Name),
+						Location: infer.Location,
+					}
+				}
+			}
+		}
+	}
+
+	if clause.TargetList != nil {
+		for _, item := range clause.TargetList.Items {
+			target, ok := item.(*ast.ResTarget)
+			if !ok || target.Name == nil {
+				continue
+			}
+			if findColumn(table, *target.Name) == nil {
+				return &sqlerr.Error{
+					Code:     "42703",
+					Message:  fmt.Sprintf("column \"%s\" of relation \"%s\" does not exist", *target.Name, table.Rel.Name),
+					Location: target.Location,
+				}
+			}
+		}
+	}
+
+	exprs := &ast.List{}
+	if clause.TargetList != nil {
+		exprs.Items = append(exprs.Items, clause.TargetList)
+	}
+	if clause.WhereClause != nil {
+		exprs.Items = append(exprs.Items, clause.WhereClause)
+	}
+	refs := astutils.Search(exprs, func(node ast.Node) bool {
+		_, ok := node.(*ast.ColumnRef)
+		return ok
+	})
+	for _, item := range refs.Items {
+		ref := item.(*ast.ColumnRef)
+		if len(ref.Fields.Items) != 2 {
+			continue
+		}
+		rel, ok := ref.Fields.Items[0].(*ast.String)
+		if !ok || rel.Str != "excluded" {
+			continue
+		}
+		col, ok := ref.Fields.Items[1].(*ast.String)
+		if !ok {
+			continue
+		}
+		if findColumn(table, col.Str) == nil {
+			return &sqlerr.Error{
+				Code:     "42703",
+				Message:  fmt.Sprintf("column \"%s\" does not exist", col.Str),
+				Location: ref.Location,
+			}
+		}
+	}
+	return nil
+}
+
+func hasConstraint(table *catalog.Table, name string) bool {
+	for _, con := range table.Constraints {
+		if con.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -1,32 +1,58 @@
 package validate
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/astutils"
 	"github.com/kyleconroy/sqlc/internal/sql/named"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 )
 
+type paramStyle struct {
+	desc     string
+	location int
+}
+
+func styleOf(node ast.Node) paramStyle {
+	switch n := node.(type) {
+	case *ast.ParamRef:
+		return paramStyle{"positional parameters ($1)", n.Location}
+	case *ast.FuncCall:
+		return paramStyle{"named parameter functions (sqlc.arg)", n.Location}
+	case *ast.A_Expr:
+		return paramStyle{"named parameters (@name)", n.Location}
+	}
+	return paramStyle{}
+}
+
 // A query can use one (and only one) of the following formats:
 // - positional parameters           $1
 // - named parameter operator        @param
 // - named parameter function calls  sqlc.arg(param)
+//
+// The error points at the first parameter that uses a different format than
+// the ones before it.
 func ParamStyle(n ast.Node) error {
-	positional := astutils.Search(n, func(node ast.Node) bool {
+	found := astutils.Search(n, func(node ast.Node) bool {
 		_, ok := node.(*ast.ParamRef)
-		return ok
+		return ok || named.IsParamFunc(node) || named.IsParamSign(node)
 	})
-	namedFunc := astutils.Search(n, named.IsParamFunc)
-	namedSign := astutils.Search(n, named.IsParamSign)
-	for _, check := range []bool{
-		len(positional.Items) > 0 && len(namedSign.Items)+len(namedFunc.Items) > 0,
-		len(namedFunc.Items) > 0 && len(namedSign.Items)+len(positional.Items) > 0,
-		len(namedSign.Items) > 0 && len(positional.Items)+len(namedFunc.Items) > 0,
-	} {
-		if check {
+	if len(found.Items) == 0 {
+		return nil
+	}
+	styles := make([]paramStyle, len(found.Items))
+	for i, node := range found.Items {
+		styles[i] = styleOf(node)
+	}
+	sort.SliceStable(styles, func(i, j int) bool { return styles[i].location < styles[j].location })
+	for _, style := range styles[1:] {
+		if style.desc != styles[0].desc {
 			return &sqlerr.Error{
-				Code:    "", // TODO: Pick a new error code
-				Message: "query mixes positional parameters ($1) and named parameters (sqlc.arg or @arg)",
+				Code:     "", // TODO: Pick a new error code
+				Message:  fmt.Sprintf("query mixes %s and %s", styles[0].desc, style.desc),
+				Location: style.location,
 			}
 		}
 	}
@@ -0,0 +1,123 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// Functions that take a sequence as their first argument
+var sequenceFuncs = map[string]bool{
+	"currval": true,
+	"nextval": true,
+	"setval":  true,
+}
+
+type sequenceVisitor struct {
+	catalog *catalog.Catalog
+	err     error
+}
+
+func (v *sequenceVisitor) Visit(node ast.Node) astutils.Visitor {
+	if v.err != nil {
+		return nil
+	}
+	call, ok := node.(*ast.FuncCall)
+	if !ok || call.Func == nil {
+		return v
+	}
+	if call.Func.Schema != "" && call.Func.Schema != "pg_catalog" {
+		return v
+	}
+	if !sequenceFuncs[strings.ToLower(call.Func.Name)] || call.Args == nil || len(call.Args.Items) == 0 {
+		return v
+	}
+	arg := call.Args.Items[0]
+	if cast, ok := arg.(*ast.TypeCast); ok {
+		arg = cast.Arg
+	}
+	con, ok := arg.(*ast.A_Const)
+	if !ok {
+		return v
+	}
+	str, ok := con.Val.(*ast.String)
+	if !ok {
+		return v
+	}
+	rel, ok := parseRegclass(str.Str)
+	if !ok {
+		return v
+	}
+	if _, err := v.catalog.GetSequence(rel); err == nil {
+		return v
+	}
+	if _, err := v.catalog.GetTable(rel); err == nil {
+		v.err = &sqlerr.Error{
+			Code:     "42809",
+			Message:  fmt.Sprintf("\"%s\" is not a sequence", rel.Name),
+			Location: con.Location,
+		}
+		return nil
+	}
+	name := rel.Name
+	if rel.Schema != "" {
+		name = rel.Schema + "." + rel.Name
+	}
+	v.err = &sqlerr.Error{
+		Err:      sqlerr.NotFound,
+		Code:     "42P01",
+		Message:  fmt.Sprintf("relation \"%s\"", name),
+		Location: con.Location,
+	}
+	return nil
+}
+
+// parseRegclass parses a relation name the way a regclass literal is read:
+// unquoted identifiers are folded to lower case
+func parseRegclass(s string) (*ast.TableName, bool) {
+	var parts []string
+	var b strings.Builder
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case ch == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+			b.WriteByte('"')
+			i++
+		case ch == '"':
+			quoted = !quoted
+		case ch == '.' && !quoted:
+			parts = append(parts, b.String())
+			b.Reset()
+		case !quoted && 'A' <= ch && ch <= 'Z':
+			b.WriteByte(ch + 'a' - 'A')
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	parts = append(parts, b.String())
+	if quoted {
+		return nil, false
+	}
+	switch len(parts) {
+	case 1:
+		return &ast.TableName{Name: parts[0]}, true
+	case 2:
+		return &ast.TableName{Schema: parts[0], Name: parts[1]}, true
+	case 3:
+		return &ast.TableName{Catalog: parts[0], Schema: parts[1], Name: parts[2]}, true
+	}
+	return nil, false
+}
+
+// Sequences checks that sequence functions called with a literal name, such
+// as nextval('orders_id_seq'), refer to a sequence in the catalog
+func Sequences(c *catalog.Catalog, n ast.Node) error {
+	visitor := sequenceVisitor{catalog: c}
+	astutils.Walk(&visitor, n)
+	return visitor.err
+}
@@ -0,0 +1,39 @@
+package validate
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/named"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// SqlcSlice checks that sqlc.slice is only used as an item of an IN list. Its
+// parameter is expanded to a comma-separated list of placeholders, which isn't
+// valid SQL anywhere else.
+func SqlcSlice(n ast.Node) error {
+	allowed := map[ast.Node]bool{}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		expr, ok := node.(*ast.A_Expr)
+		if !ok || expr.Kind != ast.AEXPR_IN {
+			return
+		}
+		list, ok := expr.Rexpr.(*ast.List)
+		if !ok {
+			return
+		}
+		for _, item := range list.Items {
+			allowed[item] = true
+		}
+	}), n)
+	found := astutils.Search(n, named.IsSqlcSliceFunc)
+	for _, node := range found.Items {
+		if !allowed[node] {
+			return &sqlerr.Error{
+				Code:     "", // TODO: Pick a new error code
+				Message:  "sqlc.slice can only be used as an item of an IN (...) list",
+				Location: node.Pos(),
+			}
+		}
+	}
+	return nil
+}